@@ -0,0 +1,95 @@
+// Command flowers is a command line flower breeding calculator.
+//
+//	flowers breed -species rose RrYyWWss rrYYWWss
+//	flowers plan -species rose -colour blue
+//	flowers seeds -species tulip
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/flowers"
+)
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: flowers breed|plan|seeds -species <species> [flags] [parents]")
+	os.Exit(2)
+}
+
+func main() {
+	log.SetFlags(0)
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	cmd := flag.NewFlagSet(os.Args[1], flag.ExitOnError)
+	speciesName := cmd.String("species", "rose", "Flower species")
+	colour := cmd.String("colour", "blue", "Target colour for plan")
+	cmd.Parse(os.Args[2:])
+
+	species, err := flowers.ParseSpecies(*speciesName)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	switch os.Args[1] {
+	case "breed":
+		if cmd.NArg() != 2 {
+			usage()
+		}
+		breed(species, cmd.Arg(0), cmd.Arg(1))
+	case "plan":
+		plan(species, *colour)
+	case "seeds":
+		for _, seed := range species.Seeds() {
+			fmt.Printf("%-8s %s\n", seed.Colour, seed.Genotype)
+		}
+	default:
+		usage()
+	}
+}
+
+func breed(species flowers.Species, a, b string) {
+	parentA, err := flowers.ParseGenotype(species, a)
+	if err != nil {
+		log.Fatal(err)
+	}
+	parentB, err := flowers.ParseGenotype(species, b)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	outcomes, err := flowers.Breed(parentA, parentB)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("%s (%s) x %s (%s)\n\n", parentA, parentA.Colour(), parentB, parentB.Colour())
+	for _, odds := range flowers.Colours(outcomes) {
+		fmt.Printf("%-8s %6.2f%%\n", odds.Colour, 100*odds.Probability)
+	}
+	fmt.Println()
+	for _, o := range outcomes {
+		fmt.Printf("%s  %-8s %6.2f%%\n", o.Genotype, o.Colour, 100*o.Probability)
+	}
+}
+
+func plan(species flowers.Species, colour string) {
+	p, err := flowers.PlanColour(species, colour)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println("Seeds:")
+	for _, seed := range p.Seeds {
+		fmt.Printf("  %-8s %s\n", seed.Colour, seed.Genotype)
+	}
+	for i, step := range p.Steps {
+		fmt.Printf("\n%d. breed %s (%s) with %s (%s)\n", i+1,
+			step.ParentA, step.ParentA.Colour(), step.ParentB, step.ParentB.Colour())
+		fmt.Printf("   keep %s offspring (%.2f%%): %v\n", step.Colour, 100*step.Probability, step.Offspring)
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
 	"encoding/gob"
 	"flag"
 	"fmt"
@@ -11,13 +12,19 @@ import (
 	"time"
 
 	"github.com/alexedwards/scs/v2"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awsConfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/mcgigglepop/acnh-finder/server/internal/auth/local"
 	"github.com/mcgigglepop/acnh-finder/server/internal/cognito"
 	"github.com/mcgigglepop/acnh-finder/server/internal/config"
 	"github.com/mcgigglepop/acnh-finder/server/internal/dynamodb"
 	"github.com/mcgigglepop/acnh-finder/server/internal/handlers"
 	"github.com/mcgigglepop/acnh-finder/server/internal/helpers"
+	"github.com/mcgigglepop/acnh-finder/server/internal/memory"
 	"github.com/mcgigglepop/acnh-finder/server/internal/render"
+	"github.com/mcgigglepop/acnh-finder/server/internal/repository"
+	"github.com/mcgigglepop/acnh-finder/server/internal/seed"
+	"github.com/mcgigglepop/acnh-finder/server/internal/sqlite"
 )
 
 const portNumber = ":8080"
@@ -44,7 +51,7 @@ func main() {
 	log.Fatal(srv.ListenAndServe())
 }
 
-// run initializes the application config, session, AWS clients, and templates
+// run initializes the application config, session, stores, auth provider, and templates
 func run() error {
 	// Register model for session storage
 	gob.Register(map[string]int{})
@@ -52,6 +59,13 @@ func run() error {
 	// Define command-line flags
 	inProduction := flag.Bool("production", true, "Application is in production")
 	useCache := flag.Bool("cache", true, "Use template cache")
+	sessionLifetime := flag.Duration("session-lifetime", 30*24*time.Hour, "Session lifetime; match the refresh token validity")
+	storeKind := flag.String("store", "dynamodb", "Persistence backend (dynamodb, memory or sqlite)")
+	sqlitePath := flag.String("sqlite-path", "acnh-finder.db", "SQLite database file used by -store=sqlite")
+
+	// Auth flags
+	authKind := flag.String("auth", "", "Auth provider (cognito or local); cognito with -store=dynamodb, local otherwise")
+	authSecret := flag.String("auth-secret", "", "Token signing secret used by -auth=local (random if empty)")
 
 	// Cognito flags
 	cognitoUserPoolID := flag.String("cognito-user-pool-id", "", "Cognito user pool ID")
@@ -60,8 +74,16 @@ func run() error {
 	// Parse flags
 	flag.Parse()
 
+	// Offline stores default to offline auth, so they run without AWS
+	if *authKind == "" {
+		*authKind = "local"
+		if *storeKind == "dynamodb" {
+			*authKind = "cognito"
+		}
+	}
+
 	// Validate Cognito flags
-	if *cognitoUserPoolID == "" || *cognitoClientID == "" {
+	if *authKind == "cognito" && (*cognitoUserPoolID == "" || *cognitoClientID == "") {
 		fmt.Println("Missing Cognito flags")
 		os.Exit(1)
 	}
@@ -78,31 +100,91 @@ func run() error {
 
 	// Configure session management
 	session = scs.New()
-	session.Lifetime = 24 * time.Hour
+	session.Lifetime = *sessionLifetime
 	session.Cookie.Persist = true
 	session.Cookie.SameSite = http.SameSiteLaxMode
 	session.Cookie.Secure = app.InProduction
 	app.Session = session
 
-	// Load AWS config
-	awsCfg, err := awsConfig.LoadDefaultConfig(context.TODO())
-	if err != nil {
-		log.Fatal("failed to load AWS config:", err)
+	// Load AWS config, only needed by the AWS backed store and auth provider
+	var awsCfg aws.Config
+	if *storeKind == "dynamodb" || *authKind == "cognito" {
+		cfg, err := awsConfig.LoadDefaultConfig(context.TODO())
+		if err != nil {
+			log.Fatal("failed to load AWS config:", err)
+		}
+		awsCfg = cfg
 	}
 
-	// Set Cognito client
-	cognitoClient, err := cognito.NewCognitoClientWithCfg(awsCfg, *cognitoUserPoolID, *cognitoClientID)
-	if err != nil {
-		log.Fatal("failed to create Cognito client:", err)
+	// Set persistence stores
+	var credentials repository.CredentialStore
+	switch *storeKind {
+	case "dynamodb":
+		collection := dynamodb.NewAppClient(awsCfg, "UserCollectibles")
+		app.Stores = &config.Stores{
+			UserProfile: dynamodb.NewAppClient(awsCfg, "UserProfiles"),
+			Catalog:     dynamodb.NewCatalogClient(awsCfg, "Collectibles", collection),
+			Collection:  collection,
+			Villager:    dynamodb.NewAppClient(awsCfg, "Villagers"),
+		}
+	case "memory":
+		store := memory.New(seed.Catalog(), seed.Villagers())
+		app.Stores = &config.Stores{
+			UserProfile: store,
+			Catalog:     store,
+			Collection:  store,
+			Villager:    store,
+		}
+		credentials = store
+		infoLog.Println("Using in-memory store; data is lost on restart")
+	case "sqlite":
+		store, err := sqlite.Open(context.TODO(), *sqlitePath)
+		if err != nil {
+			return fmt.Errorf("failed to open sqlite store: %w", err)
+		}
+		if err := store.SeedCatalog(context.TODO(), seed.Catalog()); err != nil {
+			return fmt.Errorf("failed to seed sqlite store: %w", err)
+		}
+		if err := store.SeedVillagers(context.TODO(), seed.Villagers()); err != nil {
+			return fmt.Errorf("failed to seed sqlite store: %w", err)
+		}
+		app.Stores = &config.Stores{
+			UserProfile: store,
+			Catalog:     store,
+			Collection:  store,
+			Villager:    store,
+		}
+		credentials = store
+		infoLog.Println("Using sqlite store at", *sqlitePath)
+	default:
+		return fmt.Errorf("unknown store %q", *storeKind)
 	}
 
-	app.CognitoClient = cognitoClient
-
-	// Set DynamoDB clients
-	app.Dynamo = &config.DynamoService{
-		UserProfile: dynamodb.NewAppClient(awsCfg, "UserProfiles"),
-		Fish: dynamodb.NewAppClient(awsCfg, "Fish"),
-		UserFish: dynamodb.NewAppClient(awsCfg, "UserFish"),
+	// Set auth provider
+	switch *authKind {
+	case "cognito":
+		cognitoClient, err := cognito.NewCognitoClientWithCfg(awsCfg, *cognitoUserPoolID, *cognitoClientID)
+		if err != nil {
+			log.Fatal("failed to create Cognito client:", err)
+		}
+		app.Auth = cognitoClient
+	case "local":
+		if credentials == nil {
+			return fmt.Errorf("-auth=local needs -store=memory or -store=sqlite")
+		}
+
+		secret := []byte(*authSecret)
+		if len(secret) == 0 {
+			secret = make([]byte, 32)
+			if _, err := rand.Read(secret); err != nil {
+				return fmt.Errorf("failed to generate auth secret: %w", err)
+			}
+			infoLog.Println("No -auth-secret given; tokens will not survive a restart")
+		}
+
+		app.Auth = local.New(credentials, secret, infoLog)
+	default:
+		return fmt.Errorf("unknown auth provider %q", *authKind)
 	}
 
 	// Create template cache
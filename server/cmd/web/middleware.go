@@ -2,6 +2,7 @@ package main
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/mcgigglepop/acnh-finder/server/internal/helpers"
 	"github.com/justinas/nosurf"
@@ -39,3 +40,54 @@ func Auth(next http.Handler) http.Handler {
 		next.ServeHTTP(w, r)
 	})
 }
+
+// refreshWindow is how close to expiry the access token may get before it is refreshed
+const refreshWindow = 5 * time.Minute
+
+// RefreshTokens renews the auth tokens shortly before they expire. If the
+// refresh fails the session is destroyed and the user has to log in again.
+func RefreshTokens(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		expiry := time.Unix(session.GetInt64(r.Context(), "token_expiry"), 0)
+		if time.Until(expiry) > refreshWindow {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		refreshToken := session.GetString(r.Context(), "refresh_token")
+		if refreshToken == "" {
+			expireSession(w, r)
+			return
+		}
+
+		tokens, err := app.Auth.RefreshTokens(r.Context(), refreshToken)
+		if err != nil {
+			app.ErrorLog.Println("Token refresh failed:", err)
+			expireSession(w, r)
+			return
+		}
+
+		sub, err := app.Auth.ExtractSubFromToken(r.Context(), tokens.IdToken)
+		if err != nil || sub != session.GetString(r.Context(), "user_id") {
+			app.ErrorLog.Println("Refreshed ID token rejected:", err)
+			expireSession(w, r)
+			return
+		}
+
+		helpers.PutAuthTokens(r, tokens)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// expireSession logs the user out and sends them back to the login page
+func expireSession(w http.ResponseWriter, r *http.Request) {
+	if err := session.Destroy(r.Context()); err != nil {
+		app.ErrorLog.Println("Session destroy failed:", err)
+	}
+	if err := session.RenewToken(r.Context()); err != nil {
+		app.ErrorLog.Println("Session token renewal failed:", err)
+	}
+
+	session.Put(r.Context(), "warning", "Your session has expired. Please log in again.")
+	http.Redirect(w, r, "/login", http.StatusSeeOther)
+}
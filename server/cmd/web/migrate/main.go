@@ -0,0 +1,123 @@
+// Command migrate copies catch records from the per-critter UserFish,
+// UserBugs and UserSeaCreatures tables into UserCollectibles. It is safe to
+// run more than once: records already copied keep their first caught_at.
+// Run it right after deploying the collectibles tables, since it marks
+// caught again anything a user has unmarked in the meantime. The old tables
+// are kept, protected from destroy, until it has run in every environment.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	sdkdynamodb "github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+)
+
+// source is an old catch table and the category its records belong to
+type source struct {
+	table    string
+	prefix   string // sort key prefix of the old records, e.g. "FISH#"
+	category string
+}
+
+var sources = []source{
+	{"UserFish", "FISH#", models.CategoryFish},
+	{"UserBugs", "BUG#", models.CategoryBug},
+	{"UserSeaCreatures", "SEA#", models.CategorySeaCreature},
+}
+
+// legacyRecord is a row of an old catch table. Fish records were read as
+// "Caught" but written as "caught", so either may be set.
+type legacyRecord struct {
+	PK           string `dynamodbav:"PK"`
+	SK           string `dynamodbav:"SK"`
+	Caught       bool   `dynamodbav:"caught"`
+	LegacyCaught bool   `dynamodbav:"Caught"`
+}
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "Report what would be copied without writing")
+	target := flag.String("table", "UserCollectibles", "Table to copy catch records into")
+	flag.Parse()
+
+	ctx := context.TODO()
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+	client := sdkdynamodb.NewFromConfig(cfg)
+
+	now := time.Now().UTC().Format(time.RFC3339Nano)
+	for _, src := range sources {
+		copied, skipped := 0, 0
+		paginator := sdkdynamodb.NewScanPaginator(client, &sdkdynamodb.ScanInput{
+			TableName: aws.String(src.table),
+		})
+		for paginator.HasMorePages() {
+			out, err := paginator.NextPage(ctx)
+			if err != nil {
+				log.Fatalf("failed to scan %s: %v", src.table, err)
+			}
+
+			var records []legacyRecord
+			if err := attributevalue.UnmarshalListOfMaps(out.Items, &records); err != nil {
+				log.Fatalf("failed to unmarshal %s: %v", src.table, err)
+			}
+
+			for _, record := range records {
+				userID, okUser := strings.CutPrefix(record.PK, "USER#")
+				id, okID := strings.CutPrefix(record.SK, src.prefix)
+				if !okUser || !okID || !(record.Caught || record.LegacyCaught) {
+					skipped++
+					continue
+				}
+
+				if !*dryRun {
+					if err := copyCaught(ctx, client, *target, userID, src.category, id, now); err != nil {
+						log.Fatalf("failed to copy %s %s for %s: %v", src.category, id, userID, err)
+					}
+				}
+				copied++
+			}
+		}
+
+		log.Printf("%s: %d caught records copied, %d skipped", src.table, copied, skipped)
+	}
+
+	if *dryRun {
+		log.Println("dry run: nothing was written")
+	}
+}
+
+// copyCaught marks the collectible caught in the new table, matching the
+// records the app writes itself. Old records carry no timestamp, so
+// caught_at is the time of the migration unless the user has since caught
+// it again.
+func copyCaught(ctx context.Context, client *sdkdynamodb.Client, table, userID, category, id, now string) error {
+	_, err := client.UpdateItem(ctx, &sdkdynamodb.UpdateItemInput{
+		TableName: aws.String(table),
+		Key: map[string]types.AttributeValue{
+			"PK": &types.AttributeValueMemberS{Value: fmt.Sprintf("USER#%s", userID)},
+			"SK": &types.AttributeValueMemberS{Value: strings.ToUpper(category) + "#" + id},
+		},
+		UpdateExpression: aws.String("SET user_id = :user_id, category = :category, id = :id, caught = :true, caught_at = if_not_exists(caught_at, :now)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":user_id":  &types.AttributeValueMemberS{Value: userID},
+			":category": &types.AttributeValueMemberS{Value: category},
+			":id":       &types.AttributeValueMemberS{Value: id},
+			":true":     &types.AttributeValueMemberBOOL{Value: true},
+			":now":      &types.AttributeValueMemberS{Value: now},
+		},
+	})
+	return err
+}
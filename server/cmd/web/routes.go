@@ -24,25 +24,147 @@ func routes(app *config.AppConfig) http.Handler {
 
 	mux.Get("/email-verification", handlers.Repo.EmailVerificationGet)
 	mux.Post("/email-verification", handlers.Repo.EmailVerificationPost)	
+	mux.Post("/email-verification/resend", handlers.Repo.ResendConfirmationPost)
+
+	mux.Get("/forgot-password", handlers.Repo.ForgotPasswordGet)
+	mux.Post("/forgot-password", handlers.Repo.ForgotPasswordPost)
+
+	mux.Get("/reset-password", handlers.Repo.ResetPasswordGet)
+	mux.Post("/reset-password", handlers.Repo.ResetPasswordPost)
+
+	mux.Post("/logout", handlers.Repo.LogoutPost)
 	
 	mux.Route("/", func(mux chi.Router) {
 		mux.Use(Auth) // if you want to apply auth just for these
+		mux.Use(RefreshTokens)
 		mux.Get("/dashboard", handlers.Repo.DashboardGet)
 		mux.Get("/choose-hemisphere", handlers.Repo.ChooseHemisphereGet)
 		mux.Post("/choose-hemisphere", handlers.Repo.ChooseHemispherePost)
+		mux.Post("/logout/everywhere", handlers.Repo.LogoutEverywherePost)
+	})
+
+	mux.Route("/profile", func(mux chi.Router) {
+		mux.Use(Auth)
+		mux.Use(RefreshTokens)
+
+		mux.Get("/clock", handlers.Repo.GetClock)
+		mux.Post("/clock", handlers.Repo.UpdateClockPost)
 	})
 
 	mux.Route("/fish", func(mux chi.Router) {
 		mux.Use(Auth) // if you want to apply auth just for these
+		mux.Use(RefreshTokens)
 
 		mux.Get("/filter", handlers.Repo.FishFilterGet)
 		// New JSON API to fetch filtered fish
 		mux.Get("/available", handlers.Repo.GetAvailableFish)
+		mux.Get("/this-month", handlers.Repo.FishThisMonthGet)
+		mux.Get("/this-month/list", handlers.Repo.GetFishThisMonth)
+		mux.Get("/next", handlers.Repo.GetFishNext)
 
 		// single endpoint to handle insert/delete
 		mux.Post("/userfish", handlers.Repo.UpdateUserFish)
 	})
 
+	// Generic JSON API over every collectible category
+	mux.Route("/collectibles/{category}", func(mux chi.Router) {
+		mux.Use(Auth)
+		mux.Use(RefreshTokens)
+
+		mux.Get("/available", handlers.Repo.GetAvailableCollectibles)
+		mux.Post("/caught", handlers.Repo.UpdateUserCollectible)
+		mux.Post("/donated", handlers.Repo.UpdateUserDonation)
+	})
+
+	mux.Route("/bugs", func(mux chi.Router) {
+		mux.Use(Auth)
+		mux.Use(RefreshTokens)
+
+		mux.Get("/filter", handlers.Repo.BugFilterGet)
+		mux.Get("/available", handlers.Repo.GetAvailableBugs)
+		mux.Post("/userbugs", handlers.Repo.UpdateUserBug)
+	})
+
+	mux.Route("/sea-creatures", func(mux chi.Router) {
+		mux.Use(Auth)
+		mux.Use(RefreshTokens)
+
+		mux.Get("/filter", handlers.Repo.SeaCreatureFilterGet)
+		mux.Get("/available", handlers.Repo.GetAvailableSeaCreatures)
+		mux.Post("/usercreatures", handlers.Repo.UpdateUserSeaCreature)
+	})
+
+	mux.Route("/fossils", func(mux chi.Router) {
+		mux.Use(Auth)
+		mux.Use(RefreshTokens)
+
+		mux.Get("/collection", handlers.Repo.GetFossils)
+		mux.Get("/missing", handlers.Repo.GetMissingFossils)
+		mux.Post("/assessed", handlers.Repo.UpdateFossilAssessed)
+		mux.Post("/donated", handlers.Repo.UpdateFossilDonated)
+		mux.Post("/duplicates", handlers.Repo.UpdateFossilDuplicates)
+	})
+
+	mux.Route("/art", func(mux chi.Router) {
+		mux.Use(Auth)
+		mux.Use(RefreshTokens)
+
+		mux.Get("/collection", handlers.Repo.GetArt)
+		mux.Get("/missing", handlers.Repo.GetMissingArt)
+		mux.Post("/donated", handlers.Repo.UpdateArtDonated)
+	})
+
+	mux.Route("/villagers", func(mux chi.Router) {
+		mux.Use(Auth)
+		mux.Use(RefreshTokens)
+
+		mux.Get("/", handlers.Repo.GetVillagers)
+	})
+
+	mux.Route("/island", func(mux chi.Router) {
+		mux.Use(Auth)
+		mux.Use(RefreshTokens)
+
+		mux.Get("/residents", handlers.Repo.GetIsland)
+		mux.Post("/move-in", handlers.Repo.MoveInVillager)
+		mux.Post("/move-out", handlers.Repo.MoveOutVillager)
+	})
+
+	mux.Route("/recipes", func(mux chi.Router) {
+		mux.Use(Auth)
+		mux.Use(RefreshTokens)
+
+		mux.Get("/collection", handlers.Repo.GetRecipes)
+		mux.Post("/learned", handlers.Repo.UpdateRecipeLearned)
+		mux.Post("/craftable", handlers.Repo.CraftablePost)
+	})
+
+	mux.Route("/songs", func(mux chi.Router) {
+		mux.Use(Auth)
+		mux.Use(RefreshTokens)
+
+		mux.Get("/collection", handlers.Repo.GetSongs)
+		mux.Get("/request", handlers.Repo.GetSongRequest)
+		mux.Post("/owned", handlers.Repo.UpdateSongOwned)
+	})
+
+	mux.Route("/flowers", func(mux chi.Router) {
+		mux.Use(Auth)
+		mux.Use(RefreshTokens)
+
+		mux.Get("/species", handlers.Repo.GetFlowerSpecies)
+		mux.Get("/plan", handlers.Repo.GetFlowerPlan)
+		mux.Post("/breed", handlers.Repo.FlowerBreedPost)
+	})
+
+	mux.Route("/calendar", func(mux chi.Router) {
+		mux.Use(Auth)
+		mux.Use(RefreshTokens)
+
+		mux.Get("/", handlers.Repo.CalendarGet)
+		mux.Get("/events", handlers.Repo.GetCalendarEvents)
+	})
+
 	fileServer := http.FileServer(http.Dir("./static/"))
 	mux.Handle("/static/*", http.StripPrefix("/static", fileServer))
 
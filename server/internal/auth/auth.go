@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrUserExists         = errors.New("an account with this email already exists")
+	ErrInvalidPassword    = errors.New("password does not meet the requirements")
+	ErrInvalidCredentials = errors.New("incorrect email or password")
+	ErrUserNotConfirmed   = errors.New("user is not confirmed")
+	ErrCodeMismatch       = errors.New("invalid confirmation code")
+	ErrCodeExpired        = errors.New("confirmation code has expired")
+	ErrInvalidToken       = errors.New("invalid token")
+	ErrTooManyRequests    = errors.New("too many attempts, try again later")
+)
+
+// AuthResponse holds the tokens issued on a successful login or refresh
+type AuthResponse struct {
+	IdToken      string
+	AccessToken  string
+	RefreshToken string
+	// ExpiresAt is when the access and ID tokens stop being valid
+	ExpiresAt time.Time
+}
+
+// Provider registers, confirms and authenticates users
+type Provider interface {
+	RegisterUser(ctx context.Context, email, password string) error
+	ConfirmUser(ctx context.Context, email, confirmationCode string) error
+	// ResendConfirmationCode sends a new sign-up confirmation code
+	ResendConfirmationCode(ctx context.Context, email string) error
+	Login(ctx context.Context, email, password string) (*AuthResponse, error)
+	ExtractSubFromToken(ctx context.Context, idToken string) (string, error)
+	// RefreshTokens exchanges a refresh token for new ID and access tokens.
+	// The returned RefreshToken is empty unless the provider rotated it.
+	RefreshTokens(ctx context.Context, refreshToken string) (*AuthResponse, error)
+	// RevokeToken invalidates a single refresh token, ending that session
+	RevokeToken(ctx context.Context, refreshToken string) error
+	// GlobalSignOut invalidates every refresh token issued to the user
+	GlobalSignOut(ctx context.Context, accessToken string) error
+	// ForgotPassword sends a password reset code to the account's email
+	ForgotPassword(ctx context.Context, email string) error
+	// ConfirmForgotPassword sets a new password using the reset code
+	ConfirmForgotPassword(ctx context.Context, email, code, newPassword string) error
+}
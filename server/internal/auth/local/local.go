@@ -0,0 +1,407 @@
+package local
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcgigglepop/acnh-finder/server/internal/auth"
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+	"github.com/mcgigglepop/acnh-finder/server/internal/repository"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	issuer            = "acnh-finder"
+	minPasswordLength = 8
+	codeLifetime      = 24 * time.Hour
+	resetCodeLifetime = time.Hour
+	tokenLifetime     = time.Hour
+	refreshLifetime   = 30 * 24 * time.Hour
+	// maxCodeAttempts is how many wrong codes are accepted before a code
+	// stops working and the user has to ask for a new one
+	maxCodeAttempts = 5
+)
+
+// Provider authenticates users against locally stored credentials and
+// issues HS256 tokens signed with a server-side secret
+type Provider struct {
+	store  repository.CredentialStore
+	secret []byte
+	// codes receives confirmation codes, since there is no mail server
+	codes *log.Logger
+	now   func() time.Time
+}
+
+var _ auth.Provider = (*Provider)(nil)
+
+// New creates a local auth provider. Confirmation codes are written to
+// codeLog so that an operator can pass them on to the user.
+func New(store repository.CredentialStore, secret []byte, codeLog *log.Logger) *Provider {
+	return &Provider{
+		store:  store,
+		secret: secret,
+		codes:  codeLog,
+		now:    time.Now,
+	}
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// generateCode returns a random six digit confirmation code
+func generateCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+func (p *Provider) RegisterUser(ctx context.Context, email, password string) error {
+	email = normalizeEmail(email)
+
+	if len(password) < minPasswordLength {
+		return auth.ErrInvalidPassword
+	}
+
+	existing, err := p.store.GetCredential(ctx, email)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return fmt.Errorf("sign up failed: %w", err)
+	}
+	if existing != nil {
+		return auth.ErrUserExists
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	code, err := generateCode()
+	if err != nil {
+		return fmt.Errorf("failed to generate confirmation code: %w", err)
+	}
+
+	cred := models.Credential{
+		Sub:              uuid.NewString(),
+		Email:            email,
+		PasswordHash:     string(hash),
+		ConfirmationCode: code,
+		CodeExpiresAt:    p.now().Add(codeLifetime),
+	}
+	if err := p.store.PutCredential(ctx, cred); err != nil {
+		return fmt.Errorf("sign up failed: %w", err)
+	}
+
+	p.codes.Printf("Confirmation code for %s: %s", email, code)
+
+	return nil
+}
+
+func (p *Provider) ConfirmUser(ctx context.Context, email, confirmationCode string) error {
+	cred, err := p.store.GetCredential(ctx, normalizeEmail(email))
+	if errors.Is(err, repository.ErrNotFound) {
+		return auth.ErrCodeMismatch
+	}
+	if err != nil {
+		return fmt.Errorf("confirm failed: %w", err)
+	}
+
+	if cred.Confirmed {
+		return nil
+	}
+	if cred.CodeAttempts >= maxCodeAttempts {
+		return auth.ErrTooManyRequests
+	}
+	if cred.ConfirmationCode == "" || cred.ConfirmationCode != confirmationCode {
+		cred.CodeAttempts++
+		return p.codeFailed(ctx, cred, cred.CodeAttempts)
+	}
+	if p.now().After(cred.CodeExpiresAt) {
+		return auth.ErrCodeExpired
+	}
+
+	cred.Confirmed = true
+	cred.ConfirmationCode = ""
+	cred.CodeExpiresAt = time.Time{}
+	cred.CodeAttempts = 0
+
+	if err := p.store.PutCredential(ctx, *cred); err != nil {
+		return fmt.Errorf("confirm failed: %w", err)
+	}
+
+	return nil
+}
+
+// ResendConfirmationCode issues a fresh confirmation code for an
+// unconfirmed account. Unknown and confirmed accounts are ignored.
+func (p *Provider) ResendConfirmationCode(ctx context.Context, email string) error {
+	email = normalizeEmail(email)
+
+	cred, err := p.store.GetCredential(ctx, email)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("resend confirmation code failed: %w", err)
+	}
+	if cred.Confirmed {
+		return nil
+	}
+
+	code, err := generateCode()
+	if err != nil {
+		return fmt.Errorf("failed to generate confirmation code: %w", err)
+	}
+
+	cred.ConfirmationCode = code
+	cred.CodeExpiresAt = p.now().Add(codeLifetime)
+	cred.CodeAttempts = 0
+	if err := p.store.PutCredential(ctx, *cred); err != nil {
+		return fmt.Errorf("resend confirmation code failed: %w", err)
+	}
+
+	p.codes.Printf("Confirmation code for %s: %s", email, code)
+
+	return nil
+}
+
+func (p *Provider) Login(ctx context.Context, email, password string) (*auth.AuthResponse, error) {
+	cred, err := p.store.GetCredential(ctx, normalizeEmail(email))
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, auth.ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, fmt.Errorf("login failed: %w", err)
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(cred.PasswordHash), []byte(password)); err != nil {
+		return nil, auth.ErrInvalidCredentials
+	}
+	if !cred.Confirmed {
+		return nil, auth.ErrUserNotConfirmed
+	}
+
+	return p.issue(cred, true)
+}
+
+// RefreshTokens verifies a locally issued refresh token and signs new ID
+// and access tokens for the same account
+func (p *Provider) RefreshTokens(ctx context.Context, refreshToken string) (*auth.AuthResponse, error) {
+	c, cred, err := p.verifyLive(ctx, refreshToken, "refresh")
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := p.store.IsTokenRevoked(ctx, c.ID)
+	if err != nil {
+		return nil, fmt.Errorf("token refresh failed: %w", err)
+	}
+	if revoked {
+		return nil, auth.ErrInvalidToken
+	}
+
+	return p.issue(cred, false)
+}
+
+// RevokeToken stops a refresh token from being used again. Tokens that no
+// longer verify are already unusable, so they are ignored.
+func (p *Provider) RevokeToken(ctx context.Context, refreshToken string) error {
+	c, err := p.parse(refreshToken, "refresh")
+	if err != nil {
+		return nil
+	}
+
+	if err := p.store.RevokeToken(ctx, c.ID, time.Unix(c.ExpiresAt, 0)); err != nil {
+		return fmt.Errorf("revoke token failed: %w", err)
+	}
+	return nil
+}
+
+// GlobalSignOut invalidates every token issued to the user so far
+func (p *Provider) GlobalSignOut(ctx context.Context, accessToken string) error {
+	_, cred, err := p.verifyLive(ctx, accessToken, "access")
+	if err != nil {
+		return err
+	}
+
+	cred.TokensValidAfter = p.now()
+	if err := p.store.PutCredential(ctx, *cred); err != nil {
+		return fmt.Errorf("global sign out failed: %w", err)
+	}
+	return nil
+}
+
+// ForgotPassword issues a reset code. Unknown emails are ignored so the
+// response does not reveal which accounts exist.
+func (p *Provider) ForgotPassword(ctx context.Context, email string) error {
+	email = normalizeEmail(email)
+
+	cred, err := p.store.GetCredential(ctx, email)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("forgot password failed: %w", err)
+	}
+
+	code, err := generateCode()
+	if err != nil {
+		return fmt.Errorf("failed to generate reset code: %w", err)
+	}
+
+	cred.ResetCode = code
+	cred.ResetExpiresAt = p.now().Add(resetCodeLifetime)
+	cred.ResetAttempts = 0
+	if err := p.store.PutCredential(ctx, *cred); err != nil {
+		return fmt.Errorf("forgot password failed: %w", err)
+	}
+
+	p.codes.Printf("Password reset code for %s: %s", email, code)
+
+	return nil
+}
+
+// ConfirmForgotPassword replaces the password if the reset code matches
+func (p *Provider) ConfirmForgotPassword(ctx context.Context, email, code, newPassword string) error {
+	cred, err := p.store.GetCredential(ctx, normalizeEmail(email))
+	if errors.Is(err, repository.ErrNotFound) {
+		return auth.ErrCodeMismatch
+	}
+	if err != nil {
+		return fmt.Errorf("confirm forgot password failed: %w", err)
+	}
+
+	if cred.ResetAttempts >= maxCodeAttempts {
+		return auth.ErrTooManyRequests
+	}
+	if cred.ResetCode == "" || cred.ResetCode != code {
+		cred.ResetAttempts++
+		return p.codeFailed(ctx, cred, cred.ResetAttempts)
+	}
+	if p.now().After(cred.ResetExpiresAt) {
+		return auth.ErrCodeExpired
+	}
+	if len(newPassword) < minPasswordLength {
+		return auth.ErrInvalidPassword
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	cred.PasswordHash = string(hash)
+	cred.ResetCode = ""
+	cred.ResetExpiresAt = time.Time{}
+	cred.ResetAttempts = 0
+	// Receiving the code proves ownership of the email address
+	cred.Confirmed = true
+
+	if err := p.store.PutCredential(ctx, *cred); err != nil {
+		return fmt.Errorf("confirm forgot password failed: %w", err)
+	}
+
+	return nil
+}
+
+// codeFailed saves the account after a wrong code has been counted against
+// it. The attempt that uses up the last try is reported as too many
+// requests, as are any after it until a new code is issued.
+func (p *Provider) codeFailed(ctx context.Context, cred *models.Credential, attempts int) error {
+	if err := p.store.PutCredential(ctx, *cred); err != nil {
+		return fmt.Errorf("failed to record code attempt: %w", err)
+	}
+	if attempts >= maxCodeAttempts {
+		return auth.ErrTooManyRequests
+	}
+	return auth.ErrCodeMismatch
+}
+
+// verifyLive parses the token and checks that its account still exists, is
+// confirmed and has not been signed out since the token was issued
+func (p *Provider) verifyLive(ctx context.Context, token, tokenUse string) (*claims, *models.Credential, error) {
+	c, err := p.parse(token, tokenUse)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cred, err := p.store.GetCredential(ctx, c.Email)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, nil, auth.ErrInvalidToken
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load account: %w", err)
+	}
+	if cred.Sub != c.Subject || !cred.Confirmed {
+		return nil, nil, auth.ErrInvalidToken
+	}
+	// iat is kept to the microsecond, so a sign out cuts off the tokens
+	// issued before it in the same second but not those issued after
+	if !cred.TokensValidAfter.IsZero() && !c.issuedAt().After(cred.TokensValidAfter) {
+		return nil, nil, auth.ErrInvalidToken
+	}
+
+	return c, cred, nil
+}
+
+// issue signs ID and access tokens for the account, plus a refresh token
+// when withRefresh is set
+func (p *Provider) issue(cred *models.Credential, withRefresh bool) (*auth.AuthResponse, error) {
+	now := p.now()
+	base := claims{
+		Issuer:    issuer,
+		Subject:   cred.Sub,
+		Email:     cred.Email,
+		IssuedAt:  float64(now.UnixMicro()) / 1e6,
+		ExpiresAt: now.Add(tokenLifetime).Unix(),
+	}
+
+	id := base
+	id.TokenUse = "id"
+	idToken, err := p.sign(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign id token: %w", err)
+	}
+
+	access := base
+	access.TokenUse = "access"
+	accessToken, err := p.sign(access)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign access token: %w", err)
+	}
+
+	resp := &auth.AuthResponse{
+		IdToken:     idToken,
+		AccessToken: accessToken,
+		ExpiresAt:   time.Unix(base.ExpiresAt, 0),
+	}
+
+	if withRefresh {
+		refresh := base
+		refresh.TokenUse = "refresh"
+		refresh.ExpiresAt = now.Add(refreshLifetime).Unix()
+		resp.RefreshToken, err = p.sign(refresh)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign refresh token: %w", err)
+		}
+	}
+
+	return resp, nil
+}
+
+func (p *Provider) ExtractSubFromToken(ctx context.Context, idToken string) (string, error) {
+	c, err := p.parse(idToken, "id")
+	if err != nil {
+		return "", err
+	}
+	return c.Subject, nil
+}
@@ -0,0 +1,311 @@
+package local
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"log"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/auth"
+	"github.com/mcgigglepop/acnh-finder/server/internal/memory"
+)
+
+const (
+	testEmail    = "player@example.com"
+	testPassword = "correct horse"
+)
+
+var testNow = time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+// testProvider is a provider backed by the memory store, with its codes
+// logged to a buffer and its clock under the test's control
+type testProvider struct {
+	*Provider
+	codes *bytes.Buffer
+	clock time.Time
+}
+
+func newTestProvider(t *testing.T) *testProvider {
+	t.Helper()
+	tp := &testProvider{codes: new(bytes.Buffer), clock: testNow}
+	tp.Provider = New(memory.New(nil, nil), []byte("test-secret"), log.New(tp.codes, "", 0))
+	tp.now = func() time.Time { return tp.clock }
+	return tp
+}
+
+// lastCode returns the most recent code of the kind logged for the email
+func (tp *testProvider) lastCode(t *testing.T, kind, email string) string {
+	t.Helper()
+	matches := regexp.MustCompile(kind+` code for `+regexp.QuoteMeta(email)+`: (\d{6})`).FindAllStringSubmatch(tp.codes.String(), -1)
+	if len(matches) == 0 {
+		t.Fatalf("no %s code logged for %s", kind, email)
+	}
+	return matches[len(matches)-1][1]
+}
+
+// signUp registers and confirms the test account
+func (tp *testProvider) signUp(t *testing.T) {
+	t.Helper()
+	ctx := context.Background()
+	if err := tp.RegisterUser(ctx, testEmail, testPassword); err != nil {
+		t.Fatalf("RegisterUser: %v", err)
+	}
+	if err := tp.ConfirmUser(ctx, testEmail, tp.lastCode(t, "Confirmation", testEmail)); err != nil {
+		t.Fatalf("ConfirmUser: %v", err)
+	}
+}
+
+func (tp *testProvider) login(t *testing.T) *auth.AuthResponse {
+	t.Helper()
+	resp, err := tp.Login(context.Background(), testEmail, testPassword)
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+	return resp
+}
+
+func TestSignUpConfirmLogin(t *testing.T) {
+	ctx := context.Background()
+	tp := newTestProvider(t)
+
+	if err := tp.RegisterUser(ctx, " Player@Example.com ", testPassword); err != nil {
+		t.Fatalf("RegisterUser: %v", err)
+	}
+	if _, err := tp.Login(ctx, testEmail, testPassword); !errors.Is(err, auth.ErrUserNotConfirmed) {
+		t.Fatalf("Login before confirming err = %v, want ErrUserNotConfirmed", err)
+	}
+
+	code := tp.lastCode(t, "Confirmation", testEmail)
+	if err := tp.ConfirmUser(ctx, testEmail, "000000"+code); !errors.Is(err, auth.ErrCodeMismatch) {
+		t.Fatalf("ConfirmUser with a wrong code err = %v, want ErrCodeMismatch", err)
+	}
+	if err := tp.ConfirmUser(ctx, testEmail, code); err != nil {
+		t.Fatalf("ConfirmUser: %v", err)
+	}
+
+	resp := tp.login(t)
+	if resp.IdToken == "" || resp.AccessToken == "" || resp.RefreshToken == "" {
+		t.Fatalf("Login returned missing tokens: %+v", resp)
+	}
+	if want := testNow.Add(tokenLifetime); !resp.ExpiresAt.Equal(want) {
+		t.Errorf("ExpiresAt = %v, want %v", resp.ExpiresAt, want)
+	}
+
+	cred, err := tp.store.GetCredential(ctx, testEmail)
+	if err != nil {
+		t.Fatalf("GetCredential: %v", err)
+	}
+	sub, err := tp.ExtractSubFromToken(ctx, resp.IdToken)
+	if err != nil {
+		t.Fatalf("ExtractSubFromToken: %v", err)
+	}
+	if sub != cred.Sub {
+		t.Errorf("ExtractSubFromToken = %q, want %q", sub, cred.Sub)
+	}
+}
+
+func TestRegisterAndLoginErrors(t *testing.T) {
+	ctx := context.Background()
+	tp := newTestProvider(t)
+	tp.signUp(t)
+
+	if err := tp.RegisterUser(ctx, "other@example.com", "short"); !errors.Is(err, auth.ErrInvalidPassword) {
+		t.Errorf("RegisterUser with a short password err = %v, want ErrInvalidPassword", err)
+	}
+	if err := tp.RegisterUser(ctx, strings.ToUpper(testEmail), testPassword); !errors.Is(err, auth.ErrUserExists) {
+		t.Errorf("RegisterUser with a taken email err = %v, want ErrUserExists", err)
+	}
+	if _, err := tp.Login(ctx, testEmail, "wrong password"); !errors.Is(err, auth.ErrInvalidCredentials) {
+		t.Errorf("Login with a bad password err = %v, want ErrInvalidCredentials", err)
+	}
+	if _, err := tp.Login(ctx, "nobody@example.com", testPassword); !errors.Is(err, auth.ErrInvalidCredentials) {
+		t.Errorf("Login with an unknown email err = %v, want ErrInvalidCredentials", err)
+	}
+}
+
+func TestConfirmationAttemptLimit(t *testing.T) {
+	ctx := context.Background()
+	tp := newTestProvider(t)
+	if err := tp.RegisterUser(ctx, testEmail, testPassword); err != nil {
+		t.Fatalf("RegisterUser: %v", err)
+	}
+	code := tp.lastCode(t, "Confirmation", testEmail)
+
+	for i := 1; i < maxCodeAttempts; i++ {
+		if err := tp.ConfirmUser(ctx, testEmail, "wrong"); !errors.Is(err, auth.ErrCodeMismatch) {
+			t.Fatalf("wrong code %d err = %v, want ErrCodeMismatch", i, err)
+		}
+	}
+	if err := tp.ConfirmUser(ctx, testEmail, "wrong"); !errors.Is(err, auth.ErrTooManyRequests) {
+		t.Fatalf("last wrong code err = %v, want ErrTooManyRequests", err)
+	}
+	// The right code no longer works once the attempts are used up
+	if err := tp.ConfirmUser(ctx, testEmail, code); !errors.Is(err, auth.ErrTooManyRequests) {
+		t.Fatalf("ConfirmUser after the limit err = %v, want ErrTooManyRequests", err)
+	}
+
+	if err := tp.ResendConfirmationCode(ctx, testEmail); err != nil {
+		t.Fatalf("ResendConfirmationCode: %v", err)
+	}
+	if err := tp.ConfirmUser(ctx, testEmail, tp.lastCode(t, "Confirmation", testEmail)); err != nil {
+		t.Fatalf("ConfirmUser with a new code: %v", err)
+	}
+}
+
+func TestResetAttemptLimit(t *testing.T) {
+	ctx := context.Background()
+	tp := newTestProvider(t)
+	tp.signUp(t)
+
+	if err := tp.ForgotPassword(ctx, testEmail); err != nil {
+		t.Fatalf("ForgotPassword: %v", err)
+	}
+	code := tp.lastCode(t, "Password reset", testEmail)
+
+	for i := 1; i < maxCodeAttempts; i++ {
+		if err := tp.ConfirmForgotPassword(ctx, testEmail, "wrong", "new password"); !errors.Is(err, auth.ErrCodeMismatch) {
+			t.Fatalf("wrong code %d err = %v, want ErrCodeMismatch", i, err)
+		}
+	}
+	if err := tp.ConfirmForgotPassword(ctx, testEmail, "wrong", "new password"); !errors.Is(err, auth.ErrTooManyRequests) {
+		t.Fatalf("last wrong code err = %v, want ErrTooManyRequests", err)
+	}
+	if err := tp.ConfirmForgotPassword(ctx, testEmail, code, "new password"); !errors.Is(err, auth.ErrTooManyRequests) {
+		t.Fatalf("ConfirmForgotPassword after the limit err = %v, want ErrTooManyRequests", err)
+	}
+
+	if err := tp.ForgotPassword(ctx, testEmail); err != nil {
+		t.Fatalf("ForgotPassword: %v", err)
+	}
+	if err := tp.ConfirmForgotPassword(ctx, testEmail, tp.lastCode(t, "Password reset", testEmail), "new password"); err != nil {
+		t.Fatalf("ConfirmForgotPassword with a new code: %v", err)
+	}
+	if _, err := tp.Login(ctx, testEmail, "new password"); err != nil {
+		t.Errorf("Login with the new password: %v", err)
+	}
+}
+
+// tamper replaces the token's payload, keeping its header and signature
+func tamper(token, payload string) string {
+	parts := strings.Split(token, ".")
+	parts[1] = base64.RawURLEncoding.EncodeToString([]byte(payload))
+	return strings.Join(parts, ".")
+}
+
+func TestTokenVerification(t *testing.T) {
+	ctx := context.Background()
+	tp := newTestProvider(t)
+	tp.signUp(t)
+	resp := tp.login(t)
+
+	other := newTestProvider(t)
+	other.secret = []byte("another-secret")
+	other.signUp(t)
+	foreign := other.login(t)
+
+	parts := strings.Split(resp.IdToken, ".")
+	none := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`)) + "." + parts[1] + "."
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"empty", ""},
+		{"not a JWT", "not-a-token"},
+		{"tampered payload", tamper(resp.IdToken, `{"iss":"acnh-finder","sub":"someone-else","token_use":"id","exp":9999999999}`)},
+		{"tampered signature", resp.IdToken[:len(resp.IdToken)-2] + "xx"},
+		{"unsigned", none},
+		{"signed with another secret", foreign.IdToken},
+		{"access token", resp.AccessToken},
+		{"refresh token", resp.RefreshToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tp.ExtractSubFromToken(ctx, tt.token); !errors.Is(err, auth.ErrInvalidToken) {
+				t.Errorf("ExtractSubFromToken err = %v, want ErrInvalidToken", err)
+			}
+		})
+	}
+
+	tp.clock = testNow.Add(tokenLifetime - time.Second)
+	if _, err := tp.ExtractSubFromToken(ctx, resp.IdToken); err != nil {
+		t.Errorf("ExtractSubFromToken just before expiry: %v", err)
+	}
+	tp.clock = testNow.Add(tokenLifetime)
+	if _, err := tp.ExtractSubFromToken(ctx, resp.IdToken); !errors.Is(err, auth.ErrInvalidToken) {
+		t.Errorf("ExtractSubFromToken at expiry err = %v, want ErrInvalidToken", err)
+	}
+	if _, err := tp.RefreshTokens(ctx, resp.RefreshToken); err != nil {
+		t.Errorf("RefreshTokens after the ID token expired: %v", err)
+	}
+	tp.clock = testNow.Add(refreshLifetime)
+	if _, err := tp.RefreshTokens(ctx, resp.RefreshToken); !errors.Is(err, auth.ErrInvalidToken) {
+		t.Errorf("RefreshTokens with an expired refresh token err = %v, want ErrInvalidToken", err)
+	}
+}
+
+func TestRevokeToken(t *testing.T) {
+	ctx := context.Background()
+	tp := newTestProvider(t)
+	tp.signUp(t)
+	first := tp.login(t)
+	second := tp.login(t)
+
+	refreshed, err := tp.RefreshTokens(ctx, first.RefreshToken)
+	if err != nil {
+		t.Fatalf("RefreshTokens: %v", err)
+	}
+	if refreshed.RefreshToken != "" {
+		t.Errorf("RefreshTokens rotated the refresh token")
+	}
+
+	if err := tp.RevokeToken(ctx, first.RefreshToken); err != nil {
+		t.Fatalf("RevokeToken: %v", err)
+	}
+	if _, err := tp.RefreshTokens(ctx, first.RefreshToken); !errors.Is(err, auth.ErrInvalidToken) {
+		t.Errorf("RefreshTokens with a revoked token err = %v, want ErrInvalidToken", err)
+	}
+	// Other sessions are untouched
+	if _, err := tp.RefreshTokens(ctx, second.RefreshToken); err != nil {
+		t.Errorf("RefreshTokens for another session: %v", err)
+	}
+
+	if err := tp.RevokeToken(ctx, "not-a-token"); err != nil {
+		t.Errorf("RevokeToken with a bad token: %v", err)
+	}
+}
+
+func TestGlobalSignOutCutoff(t *testing.T) {
+	ctx := context.Background()
+	tp := newTestProvider(t)
+	tp.signUp(t)
+
+	before := tp.login(t)
+	tp.clock = testNow.Add(100 * time.Millisecond)
+	if err := tp.GlobalSignOut(ctx, before.AccessToken); err != nil {
+		t.Fatalf("GlobalSignOut: %v", err)
+	}
+
+	if _, err := tp.RefreshTokens(ctx, before.RefreshToken); !errors.Is(err, auth.ErrInvalidToken) {
+		t.Errorf("RefreshTokens from before the sign out err = %v, want ErrInvalidToken", err)
+	}
+	if err := tp.GlobalSignOut(ctx, before.AccessToken); !errors.Is(err, auth.ErrInvalidToken) {
+		t.Errorf("GlobalSignOut with a token from before the sign out err = %v, want ErrInvalidToken", err)
+	}
+
+	// A login later in the same second as the sign out is not cut off
+	tp.clock = testNow.Add(200 * time.Millisecond)
+	after := tp.login(t)
+	if _, err := tp.RefreshTokens(ctx, after.RefreshToken); err != nil {
+		t.Errorf("RefreshTokens from after the sign out: %v", err)
+	}
+	if err := tp.GlobalSignOut(ctx, after.AccessToken); err != nil {
+		t.Errorf("GlobalSignOut from after the sign out: %v", err)
+	}
+}
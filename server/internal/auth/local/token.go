@@ -0,0 +1,82 @@
+package local
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcgigglepop/acnh-finder/server/internal/auth"
+)
+
+// claims is the payload of a locally signed token
+type claims struct {
+	ID        string  `json:"jti"`
+	Issuer    string  `json:"iss"`
+	Subject   string  `json:"sub"`
+	Email     string  `json:"email,omitempty"`
+	TokenUse  string  `json:"token_use"`
+	IssuedAt  float64 `json:"iat"` // to the microsecond
+	ExpiresAt int64   `json:"exp"`
+}
+
+// issuedAt returns the iat claim as a time
+func (c claims) issuedAt() time.Time {
+	return time.UnixMicro(int64(math.Round(c.IssuedAt * 1e6)))
+}
+
+var tokenHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+// sign encodes the claims as an HS256 JWT with a fresh token ID
+func (p *Provider) sign(c claims) (string, error) {
+	c.ID = uuid.NewString()
+	payload, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := tokenHeader + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return unsigned + "." + p.signature(unsigned), nil
+}
+
+// parse verifies the token signature, expiry and use, and returns its claims
+func (p *Provider) parse(token, tokenUse string) (*claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != tokenHeader {
+		return nil, auth.ErrInvalidToken
+	}
+
+	expected := p.signature(parts[0] + "." + parts[1])
+	if !hmac.Equal([]byte(parts[2]), []byte(expected)) {
+		return nil, auth.ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, auth.ErrInvalidToken
+	}
+
+	var c claims
+	if err := json.Unmarshal(payload, &c); err != nil {
+		return nil, auth.ErrInvalidToken
+	}
+
+	if c.Issuer != issuer || c.TokenUse != tokenUse || c.Subject == "" {
+		return nil, auth.ErrInvalidToken
+	}
+	if !p.now().Before(time.Unix(c.ExpiresAt, 0)) {
+		return nil, auth.ErrInvalidToken
+	}
+
+	return &c, nil
+}
+
+func (p *Provider) signature(unsigned string) string {
+	mac := hmac.New(sha256.New, p.secret)
+	mac.Write([]byte(unsigned))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
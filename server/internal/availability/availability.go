@@ -0,0 +1,164 @@
+// Package availability decides whether a collectible can be found at a given
+// month and time of day. Times are minute-accurate clock times and ranges
+// are half-open, so a fish that leaves at 09:00 is gone at 09:00 exactly.
+package availability
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+)
+
+// MinutesPerDay is the length of a day, and the exclusive upper bound of a
+// Clock
+const MinutesPerDay = 24 * 60
+
+// EndOfDay is the Clock written as "23:59" in the catalog. As the end of a
+// range it stands for midnight, so "00:00"–"23:59" covers the whole day.
+const EndOfDay Clock = MinutesPerDay - 1
+
+var ErrInvalidClock = errors.New("invalid clock time")
+
+// Clock is a time of day in minutes since midnight, 0 to 1439
+type Clock int
+
+// ParseClock parses a 24-hour "15:04" time of day
+func ParseClock(s string) (Clock, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidClock, s)
+	}
+	return ClockOf(t), nil
+}
+
+// ClockOf returns the time of day of t, in t's location
+func ClockOf(t time.Time) Clock {
+	return Clock(t.Hour()*60 + t.Minute())
+}
+
+// String formats the clock as "15:04"
+func (c Clock) String() string {
+	return fmt.Sprintf("%02d:%02d", int(c)/60, int(c)%60)
+}
+
+// Interval is a half-open time-of-day range [Start, End). An End before
+// Start wraps past midnight, and End == Start covers the whole day.
+type Interval struct {
+	Start Clock
+	End   Clock
+}
+
+// ParseInterval parses a catalog time range. An end of "23:59" is read as
+// midnight, since that is how the catalog writes "until the end of the day".
+func ParseInterval(tr models.TimeRange) (Interval, error) {
+	start, err := ParseClock(tr.Start)
+	if err != nil {
+		return Interval{}, err
+	}
+	end, err := ParseClock(tr.End)
+	if err != nil {
+		return Interval{}, err
+	}
+	if end == EndOfDay {
+		end = 0
+	}
+	return Interval{Start: start, End: end}, nil
+}
+
+// AllDay reports whether the interval covers every minute of the day
+func (i Interval) AllDay() bool {
+	return i.Start == i.End
+}
+
+// Wraps reports whether the interval runs past midnight
+func (i Interval) Wraps() bool {
+	return i.End < i.Start
+}
+
+// Contains reports whether the interval covers the clock time
+func (i Interval) Contains(c Clock) bool {
+	switch {
+	case i.AllDay():
+		return true
+	case i.Wraps():
+		return c >= i.Start || c < i.End
+	default:
+		return c >= i.Start && c < i.End
+	}
+}
+
+// Duration returns the length of the interval
+func (i Interval) Duration() time.Duration {
+	minutes := (int(i.End) - int(i.Start) + MinutesPerDay) % MinutesPerDay
+	if minutes == 0 {
+		minutes = MinutesPerDay
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
+// String formats the interval as "16:00–09:00"
+func (i Interval) String() string {
+	return i.Start.String() + "–" + i.End.String()
+}
+
+// Available reports whether any season covers the month and, within it,
+// the clock time. It fails if a season has a malformed time range.
+func Available(seasons []models.SeasonalAvailability, month int, at Clock) (bool, error) {
+	for _, s := range seasons {
+		if !slices.Contains(s.Months, month) {
+			continue
+		}
+		for _, tr := range s.TimeRanges {
+			interval, err := ParseInterval(tr)
+			if err != nil {
+				return false, err
+			}
+			if interval.Contains(at) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// InMonth reports whether any season covers the month, at any time of day
+func InMonth(seasons []models.SeasonalAvailability, month int) bool {
+	for _, s := range seasons {
+		if slices.Contains(s.Months, month) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextMonth returns the month after month, wrapping December to January
+func NextMonth(month int) int {
+	return month%12 + 1
+}
+
+// PrevMonth returns the month before month, wrapping January to December
+func PrevMonth(month int) int {
+	return (month+10)%12 + 1
+}
+
+// Leaving reports whether the seasons cover the month but not the next one
+func Leaving(seasons []models.SeasonalAvailability, month int) bool {
+	return InMonth(seasons, month) && !InMonth(seasons, NextMonth(month))
+}
+
+// Arriving reports whether the seasons cover the month but not the previous
+// one
+func Arriving(seasons []models.SeasonalAvailability, month int) bool {
+	return InMonth(seasons, month) && !InMonth(seasons, PrevMonth(month))
+}
+
+// Seasons returns the collectible's availability for the given hemisphere
+func Seasons(c models.Collectible, hemisphere string) []models.SeasonalAvailability {
+	if hemisphere == "north" {
+		return c.NorthAvailability
+	}
+	return c.SouthAvailability
+}
@@ -0,0 +1,213 @@
+package availability
+
+import (
+	"errors"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+)
+
+func clock(t *testing.T, s string) Clock {
+	t.Helper()
+	c, err := ParseClock(s)
+	if err != nil {
+		t.Fatalf("ParseClock(%q): %v", s, err)
+	}
+	return c
+}
+
+func interval(t *testing.T, start, end string) Interval {
+	t.Helper()
+	i, err := ParseInterval(models.TimeRange{Start: start, End: end})
+	if err != nil {
+		t.Fatalf("ParseInterval(%s, %s): %v", start, end, err)
+	}
+	return i
+}
+
+// season is available from start to end on every day of the months
+func season(start, end string, months ...int) models.SeasonalAvailability {
+	return models.SeasonalAvailability{
+		Months:     months,
+		TimeRanges: []models.TimeRange{{Start: start, End: end}},
+	}
+}
+
+func TestParseClock(t *testing.T) {
+	for text, want := range map[string]Clock{"00:00": 0, "09:00": 540, "23:59": EndOfDay} {
+		if got := clock(t, text); got != want {
+			t.Errorf("ParseClock(%q) = %d, want %d", text, got, want)
+		}
+		if got := want.String(); got != text {
+			t.Errorf("Clock(%d).String() = %q, want %q", want, got, text)
+		}
+	}
+
+	for _, text := range []string{"", "9", "24:00", "12:60", "noon"} {
+		if _, err := ParseClock(text); !errors.Is(err, ErrInvalidClock) {
+			t.Errorf("ParseClock(%q) err = %v, want ErrInvalidClock", text, err)
+		}
+	}
+}
+
+func TestIntervalContains(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end string
+		at         string
+		want       bool
+	}{
+		// 04:00–09:00 leaves at 09:00 exactly
+		{"before start", "04:00", "09:00", "03:59", false},
+		{"at start", "04:00", "09:00", "04:00", true},
+		{"before 09:00", "04:00", "09:00", "08:59", true},
+		{"at 09:00", "04:00", "09:00", "09:00", false},
+		{"after 09:00", "04:00", "09:00", "09:01", false},
+
+		// 09:00–16:00 arrives at 09:00 exactly
+		{"before 09:00 start", "09:00", "16:00", "08:59", false},
+		{"at 09:00 start", "09:00", "16:00", "09:00", true},
+
+		// 00:00–23:59 is the whole day
+		{"all day at midnight", "00:00", "23:59", "00:00", true},
+		{"all day at noon", "00:00", "23:59", "12:00", true},
+		{"all day at 23:58", "00:00", "23:59", "23:58", true},
+		{"all day at 23:59", "00:00", "23:59", "23:59", true},
+
+		// 21:00–04:00 wraps past midnight
+		{"wrap before start", "21:00", "04:00", "20:59", false},
+		{"wrap at start", "21:00", "04:00", "21:00", true},
+		{"wrap at 23:59", "21:00", "04:00", "23:59", true},
+		{"wrap at midnight", "21:00", "04:00", "00:00", true},
+		{"wrap after midnight", "21:00", "04:00", "00:01", true},
+		{"wrap before end", "21:00", "04:00", "03:59", true},
+		{"wrap at end", "21:00", "04:00", "04:00", false},
+		{"wrap after end", "21:00", "04:00", "04:01", false},
+		{"wrap at noon", "21:00", "04:00", "12:00", false},
+
+		// ranges ending at 23:59 run to midnight
+		{"until 23:59 at 23:59", "16:00", "23:59", "23:59", true},
+		{"until 23:59 at midnight", "16:00", "23:59", "00:00", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := interval(t, tt.start, tt.end)
+			if got := i.Contains(clock(t, tt.at)); got != tt.want {
+				t.Errorf("%s contains %s = %v, want %v", i, tt.at, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntervalShape(t *testing.T) {
+	tests := []struct {
+		start, end string
+		allDay     bool
+		wraps      bool
+		duration   time.Duration
+	}{
+		{"04:00", "09:00", false, false, 5 * time.Hour},
+		{"00:00", "23:59", true, false, 24 * time.Hour},
+		{"21:00", "04:00", false, true, 7 * time.Hour},
+		{"16:00", "23:59", false, true, 8 * time.Hour},
+		{"09:00", "09:00", true, false, 24 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		i := interval(t, tt.start, tt.end)
+		if got := i.AllDay(); got != tt.allDay {
+			t.Errorf("%s AllDay = %v, want %v", i, got, tt.allDay)
+		}
+		if got := i.Wraps(); got != tt.wraps {
+			t.Errorf("%s Wraps = %v, want %v", i, got, tt.wraps)
+		}
+		if got := i.Duration(); got != tt.duration {
+			t.Errorf("%s Duration = %v, want %v", i, got, tt.duration)
+		}
+	}
+}
+
+// TestIntervalProperties checks every interval is half-open and covers
+// exactly Duration worth of minutes
+func TestIntervalProperties(t *testing.T) {
+	halfOpen := func(start, end uint16) bool {
+		i := Interval{Start: Clock(start % MinutesPerDay), End: Clock(end % MinutesPerDay)}
+		if !i.Contains(i.Start) {
+			return false
+		}
+		return i.AllDay() || !i.Contains(i.End)
+	}
+	if err := quick.Check(halfOpen, nil); err != nil {
+		t.Error(err)
+	}
+
+	// a minute is covered when it is less than Duration past the start
+	offset := func(start, end, at uint16) bool {
+		i := Interval{Start: Clock(start % MinutesPerDay), End: Clock(end % MinutesPerDay)}
+		c := Clock(at % MinutesPerDay)
+		since := (int(c) - int(i.Start) + MinutesPerDay) % MinutesPerDay
+		return i.Contains(c) == (time.Duration(since)*time.Minute < i.Duration())
+	}
+	if err := quick.Check(offset, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAvailable(t *testing.T) {
+	seasons := []models.SeasonalAvailability{
+		season("16:00", "09:00", 11, 12, 1, 2, 3),
+		season("00:00", "23:59", 6),
+	}
+
+	tests := []struct {
+		month int
+		at    string
+		want  bool
+	}{
+		{12, "08:59", true},
+		{12, "09:00", false},
+		{12, "16:00", true},
+		{1, "00:00", true},
+		{4, "20:00", false},
+		{6, "12:00", true},
+		{6, "23:59", true},
+	}
+
+	for _, tt := range tests {
+		got, err := Available(seasons, tt.month, clock(t, tt.at))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("Available in month %d at %s = %v, want %v", tt.month, tt.at, got, tt.want)
+		}
+	}
+
+	bad := []models.SeasonalAvailability{season("4pm", "09:00", 1)}
+	if _, err := Available(bad, 1, 0); !errors.Is(err, ErrInvalidClock) {
+		t.Errorf("Available with a bad range err = %v, want ErrInvalidClock", err)
+	}
+}
+
+func TestMonthChanges(t *testing.T) {
+	if got := NextMonth(12); got != 1 {
+		t.Errorf("NextMonth(12) = %d, want 1", got)
+	}
+	if got := PrevMonth(1); got != 12 {
+		t.Errorf("PrevMonth(1) = %d, want 12", got)
+	}
+
+	// November to February wraps the year
+	seasons := []models.SeasonalAvailability{season("00:00", "23:59", 11, 12, 1, 2)}
+	for month := 1; month <= 12; month++ {
+		if got, want := Leaving(seasons, month), month == 2; got != want {
+			t.Errorf("Leaving in month %d = %v, want %v", month, got, want)
+		}
+		if got, want := Arriving(seasons, month), month == 11; got != want {
+			t.Errorf("Arriving in month %d = %v, want %v", month, got, want)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package availability
+
+import (
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+)
+
+// horizon is how many days either side of the given time Next looks at.
+// Availability repeats every year, so a year each way finds every window.
+const horizon = 366
+
+// Window is a continuous stretch of time a collectible can be found in.
+// Windows run across midnight and month ends when the next day or month
+// carries on where the last left off.
+type Window struct {
+	Start      time.Time // when the window opens; in the past if Open
+	End        time.Time // when the window closes
+	Open       bool      // the window has already opened
+	AlwaysOpen bool      // available all year round; Start and End are zero
+	OpensIn    int       // minutes until the window opens, 0 if Open
+	ClosesIn   int       // minutes until the window closes, 0 if AlwaysOpen
+}
+
+// segment is a half-open stretch of time
+type segment struct {
+	start, end time.Time
+}
+
+// daySegments returns the day's available stretches in order, merged where
+// they overlap or touch. day is midnight in the island's time zone.
+func daySegments(seasons []models.SeasonalAvailability, day time.Time) []segment {
+	at := func(minute int) time.Time {
+		return time.Date(day.Year(), day.Month(), day.Day(), 0, minute, 0, 0, day.Location())
+	}
+
+	var segments []segment
+	for _, s := range seasons {
+		if !slices.Contains(s.Months, int(day.Month())) {
+			continue
+		}
+		for _, tr := range s.TimeRanges {
+			interval, err := ParseInterval(tr)
+			if err != nil {
+				continue
+			}
+
+			switch {
+			case interval.AllDay():
+				segments = append(segments, segment{at(0), at(MinutesPerDay)})
+			case interval.Wraps():
+				segments = append(segments, segment{at(0), at(int(interval.End))})
+				segments = append(segments, segment{at(int(interval.Start)), at(MinutesPerDay)})
+			default:
+				segments = append(segments, segment{at(int(interval.Start)), at(int(interval.End))})
+			}
+		}
+	}
+
+	sort.Slice(segments, func(i, j int) bool { return segments[i].start.Before(segments[j].start) })
+	var merged []segment
+	for _, s := range segments {
+		if !s.start.Before(s.end) {
+			continue
+		}
+		if n := len(merged); n > 0 && !s.start.After(merged[n-1].end) {
+			if s.end.After(merged[n-1].end) {
+				merged[n-1].end = s.end
+			}
+			continue
+		}
+		merged = append(merged, s)
+	}
+	return merged
+}
+
+// Next returns the window the seasons are open in at now, or failing that
+// the next one to open. Days and clock times are read in now's location,
+// which should be the island's time zone. It returns false if the seasons
+// never open.
+func Next(seasons []models.SeasonalAvailability, now time.Time) (Window, bool) {
+	first := time.Date(now.Year(), now.Month(), now.Day()-horizon, 0, 0, 0, 0, now.Location())
+
+	var current segment
+	started := false
+	for d := 0; d <= 2*horizon; d++ {
+		for _, s := range daySegments(seasons, first.AddDate(0, 0, d)) {
+			if started && !s.start.After(current.end) {
+				if s.end.After(current.end) {
+					current.end = s.end
+				}
+				continue
+			}
+			if started && current.end.After(now) {
+				return newWindow(current, now), true
+			}
+			current, started = s, true
+		}
+	}
+
+	if !started || !current.end.After(now) {
+		return Window{}, false
+	}
+	if current.start.Equal(first) {
+		// open every minute of both years searched
+		return Window{Open: true, AlwaysOpen: true}, true
+	}
+	return newWindow(current, now), true
+}
+
+// newWindow describes the segment as seen from now
+func newWindow(s segment, now time.Time) Window {
+	w := Window{
+		Start:    s.start,
+		End:      s.end,
+		Open:     !s.start.After(now),
+		ClosesIn: int(s.end.Sub(now) / time.Minute),
+	}
+	if !w.Open {
+		w.OpensIn = int(s.start.Sub(now) / time.Minute)
+	}
+	return w
+}
@@ -0,0 +1,213 @@
+package availability
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+)
+
+func allMonths() []int {
+	return []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+}
+
+func TestNext(t *testing.T) {
+	at := func(year int, month time.Month, day, hour, minute int) time.Time {
+		return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	// The same creature is out in opposite seasons either side of the
+	// equator
+	creature := models.Collectible{
+		NorthAvailability: []models.SeasonalAvailability{season("21:00", "04:00", 12, 1, 2)},
+		SouthAvailability: []models.SeasonalAvailability{season("21:00", "04:00", 6, 7, 8)},
+	}
+
+	tests := []struct {
+		name       string
+		seasons    []models.SeasonalAvailability
+		now        time.Time
+		start, end time.Time
+		open       bool
+		opensIn    int
+		closesIn   int
+	}{
+		{
+			name:    "09:00 end, a minute before",
+			seasons: []models.SeasonalAvailability{season("04:00", "09:00", allMonths()...)},
+			now:     at(2026, time.May, 10, 8, 59),
+			start:   at(2026, time.May, 10, 4, 0), end: at(2026, time.May, 10, 9, 0),
+			open: true, closesIn: 1,
+		},
+		{
+			name:    "09:00 end, at 09:00",
+			seasons: []models.SeasonalAvailability{season("04:00", "09:00", allMonths()...)},
+			now:     at(2026, time.May, 10, 9, 0),
+			start:   at(2026, time.May, 11, 4, 0), end: at(2026, time.May, 11, 9, 0),
+			opensIn: 19 * 60, closesIn: 24 * 60,
+		},
+		{
+			name:    "wraparound runs past midnight",
+			seasons: []models.SeasonalAvailability{season("21:00", "04:00", allMonths()...)},
+			now:     at(2026, time.May, 11, 3, 59),
+			start:   at(2026, time.May, 10, 21, 0), end: at(2026, time.May, 11, 4, 0),
+			open: true, closesIn: 1,
+		},
+		{
+			name:    "wraparound closed at 04:00",
+			seasons: []models.SeasonalAvailability{season("21:00", "04:00", allMonths()...)},
+			now:     at(2026, time.May, 11, 4, 0),
+			start:   at(2026, time.May, 11, 21, 0), end: at(2026, time.May, 12, 4, 0),
+			opensIn: 17 * 60, closesIn: 24 * 60,
+		},
+		{
+			name:    "all day months merge into one window",
+			seasons: []models.SeasonalAvailability{season("00:00", "23:59", 3, 4, 5)},
+			now:     at(2026, time.April, 15, 12, 0),
+			start:   at(2026, time.March, 1, 0, 0), end: at(2026, time.June, 1, 0, 0),
+			open: true, closesIn: int(at(2026, time.June, 1, 0, 0).Sub(at(2026, time.April, 15, 12, 0)) / time.Minute),
+		},
+		{
+			name:    "north, opens next month",
+			seasons: Seasons(creature, "north"),
+			now:     at(2026, time.November, 30, 21, 0),
+			start:   at(2026, time.December, 1, 0, 0), end: at(2026, time.December, 1, 4, 0),
+			opensIn: 3 * 60, closesIn: 7 * 60,
+		},
+		{
+			name:    "north, wraps across the new year",
+			seasons: Seasons(creature, "north"),
+			now:     at(2026, time.December, 31, 23, 59),
+			start:   at(2026, time.December, 31, 21, 0), end: at(2027, time.January, 1, 4, 0),
+			open: true, closesIn: 4*60 + 1,
+		},
+		{
+			name:    "north, season over until next year",
+			seasons: Seasons(creature, "north"),
+			now:     at(2026, time.March, 1, 0, 0),
+			start:   at(2026, time.December, 1, 0, 0), end: at(2026, time.December, 1, 4, 0),
+			opensIn:  int(at(2026, time.December, 1, 0, 0).Sub(at(2026, time.March, 1, 0, 0)) / time.Minute),
+			closesIn: int(at(2026, time.December, 1, 4, 0).Sub(at(2026, time.March, 1, 0, 0)) / time.Minute),
+		},
+		{
+			name:    "north, last night of the season stops at midnight",
+			seasons: Seasons(creature, "north"),
+			now:     at(2026, time.February, 28, 23, 0),
+			start:   at(2026, time.February, 28, 21, 0), end: at(2026, time.March, 1, 0, 0),
+			open: true, closesIn: 60,
+		},
+		{
+			name:    "south, opens next month",
+			seasons: Seasons(creature, "south"),
+			now:     at(2026, time.May, 31, 23, 59),
+			start:   at(2026, time.June, 1, 0, 0), end: at(2026, time.June, 1, 4, 0),
+			opensIn: 1, closesIn: 4*60 + 1,
+		},
+		{
+			name:    "south, wraps across a month end",
+			seasons: Seasons(creature, "south"),
+			now:     at(2026, time.July, 31, 22, 0),
+			start:   at(2026, time.July, 31, 21, 0), end: at(2026, time.August, 1, 4, 0),
+			open: true, closesIn: 6 * 60,
+		},
+		{
+			name:    "south, season over until next year",
+			seasons: Seasons(creature, "south"),
+			now:     at(2026, time.September, 1, 0, 0),
+			start:   at(2027, time.June, 1, 0, 0), end: at(2027, time.June, 1, 4, 0),
+			opensIn:  int(at(2027, time.June, 1, 0, 0).Sub(at(2026, time.September, 1, 0, 0)) / time.Minute),
+			closesIn: int(at(2027, time.June, 1, 4, 0).Sub(at(2026, time.September, 1, 0, 0)) / time.Minute),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, ok := Next(tt.seasons, tt.now)
+			if !ok {
+				t.Fatal("Next found no window")
+			}
+			if !w.Start.Equal(tt.start) || !w.End.Equal(tt.end) {
+				t.Errorf("window = %v to %v, want %v to %v", w.Start, w.End, tt.start, tt.end)
+			}
+			if w.Open != tt.open || w.AlwaysOpen {
+				t.Errorf("Open = %v, AlwaysOpen = %v, want %v, false", w.Open, w.AlwaysOpen, tt.open)
+			}
+			if w.OpensIn != tt.opensIn || w.ClosesIn != tt.closesIn {
+				t.Errorf("OpensIn = %d, ClosesIn = %d, want %d, %d", w.OpensIn, w.ClosesIn, tt.opensIn, tt.closesIn)
+			}
+		})
+	}
+}
+
+func TestNextAlwaysAndNever(t *testing.T) {
+	now := time.Date(2026, time.December, 31, 23, 59, 0, 0, time.UTC)
+
+	w, ok := Next([]models.SeasonalAvailability{season("00:00", "23:59", allMonths()...)}, now)
+	if !ok || !w.AlwaysOpen || !w.Open {
+		t.Errorf("year-round Next = %+v, %v, want AlwaysOpen", w, ok)
+	}
+
+	if w, ok := Next(nil, now); ok {
+		t.Errorf("Next without seasons = %+v, want none", w)
+	}
+}
+
+// TestNextMatchesAvailable checks Next against Available for random seasons
+// and times: the window covers now or starts after it, it is available from
+// its first minute to its last, and it is closed either side.
+func TestNextMatchesAvailable(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	base := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	available := func(seasons []models.SeasonalAvailability, at time.Time) bool {
+		ok, err := Available(seasons, int(at.Month()), ClockOf(at))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ok
+	}
+
+	for n := 0; n < 300; n++ {
+		var months []int
+		for month := 1; month <= 12; month++ {
+			if rng.Intn(2) == 0 {
+				months = append(months, month)
+			}
+		}
+		if len(months) == 0 {
+			months = []int{rng.Intn(12) + 1}
+		}
+		start, end := Clock(rng.Intn(MinutesPerDay)), Clock(rng.Intn(MinutesPerDay))
+		seasons := []models.SeasonalAvailability{season(start.String(), end.String(), months...)}
+		now := base.Add(time.Duration(rng.Intn(2*365*MinutesPerDay)) * time.Minute)
+
+		w, ok := Next(seasons, now)
+		if !ok {
+			t.Fatalf("%v in months %v: no window at %v", seasons[0].TimeRanges, months, now)
+		}
+		if w.AlwaysOpen {
+			continue
+		}
+
+		if w.Open != available(seasons, now) {
+			t.Errorf("%v in months %v at %v: Open = %v, available = %v", seasons[0].TimeRanges, months, now, w.Open, !w.Open)
+		}
+		if w.Open == w.Start.After(now) || !w.End.After(now) {
+			t.Errorf("%v in months %v at %v: window %v to %v does not cover or follow now", seasons[0].TimeRanges, months, now, w.Start, w.End)
+		}
+		for _, edge := range []struct {
+			at   time.Time
+			want bool
+		}{
+			{w.Start.Add(-time.Minute), false},
+			{w.Start, true},
+			{w.End.Add(-time.Minute), true},
+			{w.End, false},
+		} {
+			if got := available(seasons, edge.at); got != edge.want {
+				t.Errorf("%v in months %v: window %v to %v, available at %v = %v", seasons[0].TimeRanges, months, w.Start, w.End, edge.at, got)
+			}
+		}
+	}
+}
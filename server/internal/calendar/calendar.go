@@ -0,0 +1,185 @@
+// Package calendar computes the in-game event calendar: seasonal events,
+// which differ by hemisphere, and resident birthdays.
+package calendar
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+)
+
+// Event kinds
+const (
+	KindEvent    = "event"    // a single-day island event
+	KindSeason   = "season"   // a window of several days, e.g. cherry blossoms
+	KindBirthday = "birthday" // a resident's birthday
+)
+
+// Event is one calendar entry. Start and End are midnight UTC on the first
+// and last day, so a single-day event has Start == End.
+type Event struct {
+	Name       string
+	Kind       string
+	Start      time.Time
+	End        time.Time
+	VillagerID string `json:",omitempty"` // set for birthdays
+}
+
+// day returns midnight UTC on the given date
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+// NthWeekday returns the nth weekday of the month, e.g. the 2nd Saturday of
+// January. n must be 1 to 5; a 5th weekday that doesn't exist rolls into
+// the next month.
+func NthWeekday(year int, month time.Month, weekday time.Weekday, n int) time.Time {
+	first := day(year, month, 1)
+	offset := (int(weekday) - int(first.Weekday()) + 7) % 7
+	return first.AddDate(0, 0, offset+7*(n-1))
+}
+
+// Weekdays returns every occurrence of the weekday in the month
+func Weekdays(year int, month time.Month, weekday time.Weekday) []time.Time {
+	var days []time.Time
+	for d := NthWeekday(year, month, weekday, 1); d.Month() == month; d = d.AddDate(0, 0, 7) {
+		days = append(days, d)
+	}
+	return days
+}
+
+// Easter returns Easter Sunday of the Gregorian calendar year, using the
+// anonymous Gregorian algorithm
+func Easter(year int) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	dayOfMonth := (h+l-7*m+114)%31 + 1
+	return day(year, time.Month(month), dayOfMonth)
+}
+
+func single(name string, date time.Time) Event {
+	return Event{Name: name, Kind: KindEvent, Start: date, End: date}
+}
+
+func season(name string, start, end time.Time) Event {
+	return Event{Name: name, Kind: KindSeason, Start: start, End: end}
+}
+
+// Events returns the island events of the calendar year for the hemisphere,
+// sorted by start date
+func Events(year int, hemisphere string) []Event {
+	south := hemisphere == "south"
+
+	events := []Event{
+		single("New Year's Day", day(year, time.January, 1)),
+		single("Festivale", Easter(year).AddDate(0, 0, -48)),
+		single("Bunny Day", Easter(year)),
+		season("May Day tour", day(year, time.May, 1), day(year, time.May, 7)),
+		season("International Museum Day", day(year, time.May, 18), day(year, time.May, 31)),
+		season("Wedding Season", day(year, time.June, 1), day(year, time.June, 30)),
+		single("Halloween", day(year, time.October, 31)),
+		single("Turkey Day", NthWeekday(year, time.November, time.Thursday, 4)),
+		single("Toy Day", day(year, time.December, 24)),
+		single("Countdown", day(year, time.December, 31)),
+	}
+
+	// Fishing tourneys fall on the 2nd Saturday of every third month in both
+	// hemispheres
+	for _, month := range []time.Month{time.January, time.April, time.July, time.October} {
+		events = append(events, single("Fishing Tourney", NthWeekday(year, month, time.Saturday, 2)))
+	}
+
+	// Bug-offs run through each hemisphere's summer: the 4th Saturday in the
+	// north and the 3rd Saturday in the south
+	bugOffMonths := []time.Month{time.June, time.July, time.August, time.September}
+	bugOffWeek := 4
+	if south {
+		bugOffMonths = []time.Month{time.January, time.February, time.November, time.December}
+		bugOffWeek = 3
+	}
+	for _, month := range bugOffMonths {
+		events = append(events, single("Bug-Off", NthWeekday(year, month, time.Saturday, bugOffWeek)))
+	}
+
+	// Fireworks shows are every Sunday in August in both hemispheres
+	for _, sunday := range Weekdays(year, time.August, time.Sunday) {
+		events = append(events, single("Fireworks Show", sunday))
+	}
+
+	// Seasons are offset by six months between hemispheres
+	offset := 0
+	if south {
+		offset = 6
+	}
+	shift := func(month time.Month) time.Month {
+		return time.Month((int(month)-1+offset)%12 + 1)
+	}
+	events = append(events,
+		season("Cherry-blossom season", day(year, shift(time.April), 1), day(year, shift(time.April), 10)),
+		season("Maple-leaf season", day(year, shift(time.November), 16), day(year, shift(time.November), 25)),
+	)
+
+	sortEvents(events)
+	return events
+}
+
+// Birthdays returns the villagers' birthdays in the calendar year, sorted
+// by date. Birthdays on February 29 fall on the 28th in other years.
+func Birthdays(year int, villagers []models.Villager) []Event {
+	events := make([]Event, 0, len(villagers))
+	for _, v := range villagers {
+		d := day(year, time.Month(v.BirthMonth), v.BirthDay)
+		if d.Month() != time.Month(v.BirthMonth) {
+			d = day(year, time.Month(v.BirthMonth)+1, 0)
+		}
+
+		events = append(events, Event{
+			Name:       fmt.Sprintf("%s's birthday", v.Name),
+			Kind:       KindBirthday,
+			Start:      d,
+			End:        d,
+			VillagerID: v.VillagerID,
+		})
+	}
+
+	sortEvents(events)
+	return events
+}
+
+// Year returns the island events and the villagers' birthdays for the
+// calendar year, merged in date order
+func Year(year int, hemisphere string, villagers []models.Villager) []Event {
+	events := append(Events(year, hemisphere), Birthdays(year, villagers)...)
+	sortEvents(events)
+	return events
+}
+
+// InMonth returns the events that overlap the month
+func InMonth(events []Event, month time.Month) []Event {
+	var matches []Event
+	for _, e := range events {
+		if e.Start.Month() <= month && month <= e.End.Month() {
+			matches = append(matches, e)
+		}
+	}
+	return matches
+}
+
+func sortEvents(events []Event) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Start.Before(events[j].Start)
+	})
+}
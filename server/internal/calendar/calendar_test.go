@@ -0,0 +1,154 @@
+package calendar
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+)
+
+func date(s string) time.Time {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// named returns the start dates of the events with the name, in order
+func named(events []Event, name string) []string {
+	var dates []string
+	for _, e := range events {
+		if e.Name == name {
+			dates = append(dates, e.Start.Format("2006-01-02"))
+		}
+	}
+	return dates
+}
+
+func TestEaster(t *testing.T) {
+	for year, want := range map[int]string{
+		2020: "2020-04-12",
+		2021: "2021-04-04",
+		2022: "2022-04-17",
+		2023: "2023-04-09",
+		2024: "2024-03-31",
+		2025: "2025-04-20",
+	} {
+		if got := Easter(year); !got.Equal(date(want)) {
+			t.Errorf("Easter(%d) = %s, want %s", year, got.Format("2006-01-02"), want)
+		}
+	}
+}
+
+func TestNthWeekday(t *testing.T) {
+	tests := []struct {
+		year    int
+		month   time.Month
+		weekday time.Weekday
+		n       int
+		want    string
+	}{
+		{2021, time.January, time.Saturday, 2, "2021-01-09"},
+		{2021, time.November, time.Thursday, 4, "2021-11-25"},
+		{2022, time.November, time.Thursday, 4, "2022-11-24"},
+		{2023, time.November, time.Thursday, 4, "2023-11-23"},
+		{2024, time.November, time.Thursday, 4, "2024-11-28"},
+		// the month starts on the weekday
+		{2022, time.January, time.Saturday, 1, "2022-01-01"},
+		// February 2021 has no 5th Saturday, so it rolls into March
+		{2021, time.February, time.Saturday, 5, "2021-03-06"},
+	}
+
+	for _, tt := range tests {
+		got := NthWeekday(tt.year, tt.month, tt.weekday, tt.n)
+		if !got.Equal(date(tt.want)) {
+			t.Errorf("NthWeekday(%d, %s, %s, %d) = %s, want %s",
+				tt.year, tt.month, tt.weekday, tt.n, got.Format("2006-01-02"), tt.want)
+		}
+	}
+
+	if got := len(Weekdays(2021, time.August, time.Sunday)); got != 5 {
+		t.Errorf("Weekdays(2021, August, Sunday) has %d days, want 5", got)
+	}
+	if got := len(Weekdays(2022, time.August, time.Sunday)); got != 4 {
+		t.Errorf("Weekdays(2022, August, Sunday) has %d days, want 4", got)
+	}
+}
+
+func TestEvents(t *testing.T) {
+	tests := []struct {
+		year       int
+		hemisphere string
+		name       string
+		want       []string
+	}{
+		{2021, "north", "Festivale", []string{"2021-02-15"}},
+		{2022, "north", "Festivale", []string{"2022-02-28"}},
+		{2023, "north", "Festivale", []string{"2023-02-20"}},
+		{2024, "north", "Festivale", []string{"2024-02-12"}},
+		{2024, "south", "Festivale", []string{"2024-02-12"}},
+
+		{2021, "north", "Bunny Day", []string{"2021-04-04"}},
+		{2023, "north", "Turkey Day", []string{"2023-11-23"}},
+
+		{2021, "north", "Fishing Tourney", []string{"2021-01-09", "2021-04-10", "2021-07-10", "2021-10-09"}},
+		{2022, "south", "Fishing Tourney", []string{"2022-01-08", "2022-04-09", "2022-07-09", "2022-10-08"}},
+
+		{2021, "north", "Bug-Off", []string{"2021-06-26", "2021-07-24", "2021-08-28", "2021-09-25"}},
+		{2021, "south", "Bug-Off", []string{"2021-01-16", "2021-02-20", "2021-11-20", "2021-12-18"}},
+		{2022, "north", "Bug-Off", []string{"2022-06-25", "2022-07-23", "2022-08-27", "2022-09-24"}},
+		{2022, "south", "Bug-Off", []string{"2022-01-15", "2022-02-19", "2022-11-19", "2022-12-17"}},
+
+		{2021, "north", "Fireworks Show", []string{"2021-08-01", "2021-08-08", "2021-08-15", "2021-08-22", "2021-08-29"}},
+		{2021, "south", "Fireworks Show", []string{"2021-08-01", "2021-08-08", "2021-08-15", "2021-08-22", "2021-08-29"}},
+		{2022, "north", "Fireworks Show", []string{"2022-08-07", "2022-08-14", "2022-08-21", "2022-08-28"}},
+
+		{2021, "north", "Cherry-blossom season", []string{"2021-04-01"}},
+		{2021, "south", "Cherry-blossom season", []string{"2021-10-01"}},
+		{2021, "north", "Maple-leaf season", []string{"2021-11-16"}},
+		{2021, "south", "Maple-leaf season", []string{"2021-05-16"}},
+	}
+
+	for _, tt := range tests {
+		got := named(Events(tt.year, tt.hemisphere), tt.name)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%d %s %s = %v, want %v", tt.year, tt.hemisphere, tt.name, got, tt.want)
+		}
+	}
+
+	for _, hemisphere := range []string{"north", "south"} {
+		events := Events(2023, hemisphere)
+		for i := 1; i < len(events); i++ {
+			if events[i].Start.Before(events[i-1].Start) {
+				t.Errorf("%s events are out of order: %s before %s", hemisphere, events[i-1].Name, events[i].Name)
+			}
+		}
+	}
+}
+
+func TestBirthdays(t *testing.T) {
+	villagers := []models.Villager{
+		{VillagerID: "raymond", Name: "Raymond", BirthMonth: 10, BirthDay: 1},
+		{VillagerID: "leap", Name: "Leap", BirthMonth: 2, BirthDay: 29},
+	}
+
+	tests := []struct {
+		year int
+		want []string
+	}{
+		{2023, []string{"2023-02-28", "2023-10-01"}},
+		{2024, []string{"2024-02-29", "2024-10-01"}},
+	}
+
+	for _, tt := range tests {
+		var got []string
+		for _, e := range Birthdays(tt.year, villagers) {
+			got = append(got, e.Start.Format("2006-01-02"))
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("Birthdays(%d) = %v, want %v", tt.year, got, tt.want)
+		}
+	}
+}
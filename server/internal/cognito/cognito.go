@@ -2,35 +2,48 @@ package cognito
 
 import (
 	"context"
-	"encoding/base64"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
 	cognitoTypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+	"github.com/mcgigglepop/acnh-finder/server/internal/auth"
 )
 
 type CognitoClient struct {
 	client      *cognitoidentityprovider.Client
 	userPoolID  string
 	clientAppID string
+	issuer      string
+	jwks        *keySet
+	now         func() time.Time
 }
 
-type AuthResponse struct {
-	IdToken      string
-	AccessToken  string
-	RefreshToken string
-}
+var _ auth.Provider = (*CognitoClient)(nil)
 
 func NewCognitoClientWithCfg(cfg aws.Config, userPoolID, clientAppID string) (*CognitoClient, error) {
+	// Pool IDs are "<region>_<id>"; the region is part of the token issuer
+	region, _, ok := strings.Cut(userPoolID, "_")
+	if !ok {
+		region = cfg.Region
+	}
+	if region == "" {
+		return nil, errors.New("cannot determine the user pool region")
+	}
+
+	issuer := fmt.Sprintf("https://cognito-idp.%s.amazonaws.com/%s", region, userPoolID)
+
 	return &CognitoClient{
 		client:      cognitoidentityprovider.NewFromConfig(cfg),
 		userPoolID:  userPoolID,
 		clientAppID: clientAppID,
+		issuer:      issuer,
+		jwks:        newKeySet(issuer + "/.well-known/jwks.json"),
+		now:         time.Now,
 	}, nil
 }
 
@@ -49,13 +62,13 @@ func (c *CognitoClient) RegisterUser(ctx context.Context, email, password string
 
 	_, err := c.client.SignUp(ctx, input)
 	if err != nil {
-		return fmt.Errorf("sign up failed: %w", err)
+		return fmt.Errorf("sign up failed: %w", translateError(err))
 	}
 
 	return nil
 }
 
-func (c *CognitoClient) ConfirmUser(ctx context.Context, email, confirmationCode string) (*cognitoidentityprovider.ConfirmSignUpOutput, error) {
+func (c *CognitoClient) ConfirmUser(ctx context.Context, email, confirmationCode string) error {
 
 	input := &cognitoidentityprovider.ConfirmSignUpInput{
 		ClientId:         aws.String(c.clientAppID),
@@ -63,16 +76,28 @@ func (c *CognitoClient) ConfirmUser(ctx context.Context, email, confirmationCode
 		ConfirmationCode: aws.String(confirmationCode),
 	}
 
-	result, err := c.client.ConfirmSignUp(ctx, input)
+	_, err := c.client.ConfirmSignUp(ctx, input)
 	if err != nil {
 		log.Printf("[ERROR] Cognito ConfirmSignUp failed: %v", err)
-		return result, err
+		return translateError(err)
 	}
 
-	return result, nil
+	return nil
 }
 
-func (c *CognitoClient) Login(ctx context.Context, email, password string) (*AuthResponse, error) {
+// ResendConfirmationCode asks Cognito to email a new sign-up code
+func (c *CognitoClient) ResendConfirmationCode(ctx context.Context, email string) error {
+	_, err := c.client.ResendConfirmationCode(ctx, &cognitoidentityprovider.ResendConfirmationCodeInput{
+		ClientId: aws.String(c.clientAppID),
+		Username: aws.String(email),
+	})
+	if err != nil {
+		return fmt.Errorf("resend confirmation code failed: %w", translateError(err))
+	}
+	return nil
+}
+
+func (c *CognitoClient) Login(ctx context.Context, email, password string) (*auth.AuthResponse, error) {
 	input := &cognitoidentityprovider.InitiateAuthInput{
 		AuthFlow: "USER_PASSWORD_AUTH",
 		ClientId: aws.String(c.clientAppID),
@@ -84,36 +109,101 @@ func (c *CognitoClient) Login(ctx context.Context, email, password string) (*Aut
 
 	out, err := c.client.InitiateAuth(ctx, input)
 	if err != nil {
-		return nil, fmt.Errorf("login failed: %w", err)
+		return nil, fmt.Errorf("login failed: %w", translateError(err))
+	}
+	// Challenges such as NEW_PASSWORD_REQUIRED come back without tokens
+	if out.AuthenticationResult == nil {
+		return nil, fmt.Errorf("login failed: unsupported challenge %q", out.ChallengeName)
 	}
 
-	return &AuthResponse{
-		IdToken:      aws.ToString(out.AuthenticationResult.IdToken),
-		AccessToken:  aws.ToString(out.AuthenticationResult.AccessToken),
-		RefreshToken: aws.ToString(out.AuthenticationResult.RefreshToken),
-	}, nil
+	return c.authResponse(out.AuthenticationResult), nil
 }
 
-func (c *CognitoClient) ExtractSubFromToken(ctx context.Context, idToken string) (string, error) {
-	parts := strings.Split(idToken, ".")
-	if len(parts) != 3 {
-		return "", errors.New("invalid JWT format")
+// RefreshTokens runs the REFRESH_TOKEN_AUTH flow
+func (c *CognitoClient) RefreshTokens(ctx context.Context, refreshToken string) (*auth.AuthResponse, error) {
+	input := &cognitoidentityprovider.InitiateAuthInput{
+		AuthFlow: cognitoTypes.AuthFlowTypeRefreshTokenAuth,
+		ClientId: aws.String(c.clientAppID),
+		AuthParameters: map[string]string{
+			"REFRESH_TOKEN": refreshToken,
+		},
 	}
 
-	payloadBytes, err := base64.RawURLEncoding.DecodeString(parts[1])
+	out, err := c.client.InitiateAuth(ctx, input)
 	if err != nil {
-		return "", err
+		return nil, fmt.Errorf("token refresh failed: %w", translateError(err))
+	}
+	if out.AuthenticationResult == nil {
+		return nil, errors.New("token refresh returned no tokens")
 	}
 
-	var payload map[string]interface{}
-	if err := json.Unmarshal(payloadBytes, &payload); err != nil {
-		return "", err
+	return c.authResponse(out.AuthenticationResult), nil
+}
+
+// RevokeToken revokes the refresh token and the tokens issued from it
+func (c *CognitoClient) RevokeToken(ctx context.Context, refreshToken string) error {
+	_, err := c.client.RevokeToken(ctx, &cognitoidentityprovider.RevokeTokenInput{
+		ClientId: aws.String(c.clientAppID),
+		Token:    aws.String(refreshToken),
+	})
+	if err != nil {
+		return fmt.Errorf("revoke token failed: %w", err)
 	}
+	return nil
+}
 
-	sub, ok := payload["sub"].(string)
-	if !ok {
-		return "", errors.New("sub not found in token")
+// GlobalSignOut signs the user out of every device
+func (c *CognitoClient) GlobalSignOut(ctx context.Context, accessToken string) error {
+	_, err := c.client.GlobalSignOut(ctx, &cognitoidentityprovider.GlobalSignOutInput{
+		AccessToken: aws.String(accessToken),
+	})
+	if err != nil {
+		return fmt.Errorf("global sign out failed: %w", translateError(err))
 	}
+	return nil
+}
 
-	return sub, nil
-}
\ No newline at end of file
+// ForgotPassword asks Cognito to email a password reset code
+func (c *CognitoClient) ForgotPassword(ctx context.Context, email string) error {
+	_, err := c.client.ForgotPassword(ctx, &cognitoidentityprovider.ForgotPasswordInput{
+		ClientId: aws.String(c.clientAppID),
+		Username: aws.String(email),
+	})
+	if err != nil {
+		return fmt.Errorf("forgot password failed: %w", translateError(err))
+	}
+	return nil
+}
+
+// ConfirmForgotPassword sets a new password using the emailed reset code
+func (c *CognitoClient) ConfirmForgotPassword(ctx context.Context, email, code, newPassword string) error {
+	_, err := c.client.ConfirmForgotPassword(ctx, &cognitoidentityprovider.ConfirmForgotPasswordInput{
+		ClientId:         aws.String(c.clientAppID),
+		Username:         aws.String(email),
+		ConfirmationCode: aws.String(code),
+		Password:         aws.String(newPassword),
+	})
+	if err != nil {
+		return fmt.Errorf("confirm forgot password failed: %w", translateError(err))
+	}
+	return nil
+}
+
+func (c *CognitoClient) authResponse(result *cognitoTypes.AuthenticationResultType) *auth.AuthResponse {
+	return &auth.AuthResponse{
+		IdToken:      aws.ToString(result.IdToken),
+		AccessToken:  aws.ToString(result.AccessToken),
+		RefreshToken: aws.ToString(result.RefreshToken),
+		ExpiresAt:    c.now().Add(time.Duration(result.ExpiresIn) * time.Second),
+	}
+}
+
+// ExtractSubFromToken verifies the ID token and returns its subject
+func (c *CognitoClient) ExtractSubFromToken(ctx context.Context, idToken string) (string, error) {
+	claims, err := c.VerifyToken(ctx, idToken, "id")
+	if err != nil {
+		return "", err
+	}
+
+	return claims.Subject, nil
+}
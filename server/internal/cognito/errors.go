@@ -0,0 +1,45 @@
+package cognito
+
+import (
+	"errors"
+	"fmt"
+
+	cognitoTypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+	"github.com/mcgigglepop/acnh-finder/server/internal/auth"
+)
+
+// translateError wraps known Cognito exceptions with the matching auth
+// error so handlers can react without depending on the AWS SDK
+func translateError(err error) error {
+	var (
+		codeMismatch     *cognitoTypes.CodeMismatchException
+		expiredCode      *cognitoTypes.ExpiredCodeException
+		invalidPassword  *cognitoTypes.InvalidPasswordException
+		limitExceeded    *cognitoTypes.LimitExceededException
+		tooManyRequests  *cognitoTypes.TooManyRequestsException
+		tooManyAttempts  *cognitoTypes.TooManyFailedAttemptsException
+		notAuthorized    *cognitoTypes.NotAuthorizedException
+		userNotConfirmed *cognitoTypes.UserNotConfirmedException
+		userNotFound     *cognitoTypes.UserNotFoundException
+		usernameExists   *cognitoTypes.UsernameExistsException
+	)
+
+	switch {
+	case errors.As(err, &codeMismatch):
+		return fmt.Errorf("%w: %w", auth.ErrCodeMismatch, err)
+	case errors.As(err, &expiredCode):
+		return fmt.Errorf("%w: %w", auth.ErrCodeExpired, err)
+	case errors.As(err, &invalidPassword):
+		return fmt.Errorf("%w: %w", auth.ErrInvalidPassword, err)
+	case errors.As(err, &limitExceeded), errors.As(err, &tooManyRequests), errors.As(err, &tooManyAttempts):
+		return fmt.Errorf("%w: %w", auth.ErrTooManyRequests, err)
+	case errors.As(err, &userNotConfirmed):
+		return fmt.Errorf("%w: %w", auth.ErrUserNotConfirmed, err)
+	case errors.As(err, &notAuthorized), errors.As(err, &userNotFound):
+		return fmt.Errorf("%w: %w", auth.ErrInvalidCredentials, err)
+	case errors.As(err, &usernameExists):
+		return fmt.Errorf("%w: %w", auth.ErrUserExists, err)
+	}
+
+	return err
+}
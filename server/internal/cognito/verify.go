@@ -0,0 +1,100 @@
+package cognito
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/auth"
+)
+
+// Claims are the verified claims of a Cognito ID or access token
+type Claims struct {
+	Subject   string `json:"sub"`
+	Issuer    string `json:"iss"`
+	Audience  string `json:"aud"`
+	ClientID  string `json:"client_id"`
+	TokenUse  string `json:"token_use"`
+	Email     string `json:"email"`
+	ExpiresAt int64  `json:"exp"`
+	IssuedAt  int64  `json:"iat"`
+}
+
+// VerifyToken checks the token's RS256 signature against the user pool JWKS
+// and validates its issuer, audience, token_use and expiry. tokenUse is
+// either "id" or "access".
+func (c *CognitoClient) VerifyToken(ctx context.Context, token, tokenUse string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed JWT", auth.ErrInvalidToken)
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("%w: bad header: %v", auth.ErrInvalidToken, err)
+	}
+	if header.Alg != "RS256" {
+		return nil, fmt.Errorf("%w: unexpected alg %q", auth.ErrInvalidToken, header.Alg)
+	}
+
+	key, err := c.jwks.key(ctx, header.Kid)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", auth.ErrInvalidToken, err)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: bad signature encoding", auth.ErrInvalidToken)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+		return nil, fmt.Errorf("%w: signature mismatch", auth.ErrInvalidToken)
+	}
+
+	var claims Claims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("%w: bad payload: %v", auth.ErrInvalidToken, err)
+	}
+
+	if claims.Issuer != c.issuer {
+		return nil, fmt.Errorf("%w: unexpected issuer %q", auth.ErrInvalidToken, claims.Issuer)
+	}
+	if claims.TokenUse != tokenUse {
+		return nil, fmt.Errorf("%w: unexpected token_use %q", auth.ErrInvalidToken, claims.TokenUse)
+	}
+
+	// ID tokens carry the app client in aud, access tokens in client_id
+	audience := claims.Audience
+	if tokenUse == "access" {
+		audience = claims.ClientID
+	}
+	if audience != c.clientAppID {
+		return nil, fmt.Errorf("%w: token issued to another client", auth.ErrInvalidToken)
+	}
+
+	if !c.now().Before(time.Unix(claims.ExpiresAt, 0)) {
+		return nil, fmt.Errorf("%w: token expired", auth.ErrInvalidToken)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: sub not found in token", auth.ErrInvalidToken)
+	}
+
+	return &claims, nil
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	raw, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, v)
+}
@@ -0,0 +1,294 @@
+package cognito
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/auth"
+)
+
+const (
+	testClientID = "test-client"
+	testKid      = "key-1"
+)
+
+var testNow = time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+// jwksServer is a local stand-in for the user pool's JWKS endpoint
+type jwksServer struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	keys    map[string]*rsa.PublicKey
+	fetches int
+}
+
+func newJWKSServer(t *testing.T, keys map[string]*rsa.PublicKey) *jwksServer {
+	t.Helper()
+	js := &jwksServer{keys: keys}
+	js.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		js.mu.Lock()
+		defer js.mu.Unlock()
+		js.fetches++
+
+		var doc struct {
+			Keys []jwk `json:"keys"`
+		}
+		for kid, key := range js.keys {
+			doc.Keys = append(doc.Keys, jwk{
+				Kid: kid,
+				Kty: "RSA",
+				Alg: "RS256",
+				Use: "sig",
+				N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			})
+		}
+		json.NewEncoder(w).Encode(doc)
+	}))
+	t.Cleanup(js.Close)
+	return js
+}
+
+func (js *jwksServer) setKeys(keys map[string]*rsa.PublicKey) {
+	js.mu.Lock()
+	defer js.mu.Unlock()
+	js.keys = keys
+}
+
+func (js *jwksServer) fetchCount() int {
+	js.mu.Lock()
+	defer js.mu.Unlock()
+	return js.fetches
+}
+
+// testClient returns a client whose issuer and JWKS are the stand-in, with
+// a clock that the returned pointer controls
+func testClient(js *jwksServer) (*CognitoClient, *time.Time) {
+	now := testNow
+	clock := func() time.Time { return now }
+
+	ks := newKeySet(js.URL + "/.well-known/jwks.json")
+	ks.now = clock
+
+	return &CognitoClient{
+		clientAppID: testClientID,
+		issuer:      js.URL,
+		jwks:        ks,
+		now:         clock,
+	}, &now
+}
+
+func generateKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	return key
+}
+
+func encodeSegment(t *testing.T, v interface{}) string {
+	t.Helper()
+	raw, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// signToken builds an RS256 JWT with the given header and claims
+func signToken(t *testing.T, key *rsa.PrivateKey, header map[string]string, claims map[string]interface{}) string {
+	t.Helper()
+	signingInput := encodeSegment(t, header) + "." + encodeSegment(t, claims)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func idClaims(issuer string) map[string]interface{} {
+	return map[string]interface{}{
+		"sub":       "user-123",
+		"iss":       issuer,
+		"aud":       testClientID,
+		"token_use": "id",
+		"email":     "user@example.com",
+		"iat":       testNow.Add(-time.Minute).Unix(),
+		"exp":       testNow.Add(time.Hour).Unix(),
+	}
+}
+
+func accessClaims(issuer string) map[string]interface{} {
+	return map[string]interface{}{
+		"sub":       "user-123",
+		"iss":       issuer,
+		"client_id": testClientID,
+		"token_use": "access",
+		"iat":       testNow.Add(-time.Minute).Unix(),
+		"exp":       testNow.Add(time.Hour).Unix(),
+	}
+}
+
+func rs256(kid string) map[string]string {
+	return map[string]string{"alg": "RS256", "kid": kid}
+}
+
+func TestVerifyTokenValid(t *testing.T) {
+	key := generateKey(t)
+	js := newJWKSServer(t, map[string]*rsa.PublicKey{testKid: &key.PublicKey})
+	c, _ := testClient(js)
+
+	claims, err := c.VerifyToken(context.Background(), signToken(t, key, rs256(testKid), idClaims(js.URL)), "id")
+	if err != nil {
+		t.Fatalf("id token: %v", err)
+	}
+	if claims.Subject != "user-123" || claims.Email != "user@example.com" {
+		t.Errorf("claims = %+v", claims)
+	}
+
+	if _, err := c.VerifyToken(context.Background(), signToken(t, key, rs256(testKid), accessClaims(js.URL)), "access"); err != nil {
+		t.Fatalf("access token: %v", err)
+	}
+}
+
+func TestVerifyTokenRejects(t *testing.T) {
+	key := generateKey(t)
+	other := generateKey(t)
+	js := newJWKSServer(t, map[string]*rsa.PublicKey{testKid: &key.PublicKey})
+
+	with := func(base map[string]interface{}, name string, value interface{}) map[string]interface{} {
+		claims := make(map[string]interface{}, len(base))
+		for k, v := range base {
+			claims[k] = v
+		}
+		if value == nil {
+			delete(claims, name)
+		} else {
+			claims[name] = value
+		}
+		return claims
+	}
+
+	tests := []struct {
+		name     string
+		token    string
+		tokenUse string
+	}{
+		{"malformed", "not.a-jwt", "id"},
+		{"alg none", signToken(t, key, map[string]string{"alg": "none", "kid": testKid}, idClaims(js.URL)), "id"},
+		{"alg HS256", signToken(t, key, map[string]string{"alg": "HS256", "kid": testKid}, idClaims(js.URL)), "id"},
+		{"bad signature", signToken(t, other, rs256(testKid), idClaims(js.URL)), "id"},
+		{"wrong issuer", signToken(t, key, rs256(testKid), with(idClaims(js.URL), "iss", "https://evil.example.com")), "id"},
+		{"access token as id", signToken(t, key, rs256(testKid), accessClaims(js.URL)), "id"},
+		{"id token as access", signToken(t, key, rs256(testKid), idClaims(js.URL)), "access"},
+		{"wrong aud", signToken(t, key, rs256(testKid), with(idClaims(js.URL), "aud", "other-client")), "id"},
+		{"wrong client_id", signToken(t, key, rs256(testKid), with(accessClaims(js.URL), "client_id", "other-client")), "access"},
+		{"expired", signToken(t, key, rs256(testKid), with(idClaims(js.URL), "exp", testNow.Add(-time.Second).Unix())), "id"},
+		{"expires now", signToken(t, key, rs256(testKid), with(idClaims(js.URL), "exp", testNow.Unix())), "id"},
+		{"missing sub", signToken(t, key, rs256(testKid), with(idClaims(js.URL), "sub", nil)), "id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := testClient(js)
+			claims, err := c.VerifyToken(context.Background(), tt.token, tt.tokenUse)
+			if !errors.Is(err, auth.ErrInvalidToken) {
+				t.Fatalf("VerifyToken = %+v, %v; want ErrInvalidToken", claims, err)
+			}
+		})
+	}
+}
+
+func TestVerifyTokenUnknownKid(t *testing.T) {
+	key := generateKey(t)
+	rotated := generateKey(t)
+	js := newJWKSServer(t, map[string]*rsa.PublicKey{testKid: &key.PublicKey})
+	c, now := testClient(js)
+	ctx := context.Background()
+
+	if _, err := c.VerifyToken(ctx, signToken(t, key, rs256(testKid), idClaims(js.URL)), "id"); err != nil {
+		t.Fatalf("first token: %v", err)
+	}
+	if got := js.fetchCount(); got != 1 {
+		t.Fatalf("fetches after first token = %d, want 1", got)
+	}
+
+	// The pool rotates to a new key the cache hasn't seen
+	js.setKeys(map[string]*rsa.PublicKey{testKid: &key.PublicKey, "key-2": &rotated.PublicKey})
+	rotatedToken := signToken(t, rotated, rs256("key-2"), idClaims(js.URL))
+
+	// Within the minimum refresh interval the cache is not refetched
+	*now = testNow.Add(jwksMinRefresh - time.Second)
+	if _, err := c.VerifyToken(ctx, rotatedToken, "id"); !errors.Is(err, auth.ErrInvalidToken) {
+		t.Fatalf("rotated token before min refresh: %v, want ErrInvalidToken", err)
+	}
+	if _, err := c.VerifyToken(ctx, signToken(t, key, rs256("no-such-key"), idClaims(js.URL)), "id"); !errors.Is(err, auth.ErrInvalidToken) {
+		t.Fatalf("unknown kid: %v, want ErrInvalidToken", err)
+	}
+	if got := js.fetchCount(); got != 1 {
+		t.Fatalf("fetches within min refresh = %d, want 1", got)
+	}
+
+	// Once it has passed, an unknown kid refetches and finds the new key
+	*now = testNow.Add(jwksMinRefresh)
+	if _, err := c.VerifyToken(ctx, rotatedToken, "id"); err != nil {
+		t.Fatalf("rotated token after min refresh: %v", err)
+	}
+	if got := js.fetchCount(); got != 2 {
+		t.Fatalf("fetches after min refresh = %d, want 2", got)
+	}
+
+	// A kid that is still unknown refetches at most once per interval
+	for range 3 {
+		c.VerifyToken(ctx, signToken(t, key, rs256("no-such-key"), idClaims(js.URL)), "id")
+	}
+	if got := js.fetchCount(); got != 2 {
+		t.Fatalf("fetches for repeated unknown kid = %d, want 2", got)
+	}
+}
+
+func TestVerifyTokenStaleCacheRefreshes(t *testing.T) {
+	key := generateKey(t)
+	js := newJWKSServer(t, map[string]*rsa.PublicKey{testKid: &key.PublicKey})
+	c, now := testClient(js)
+
+	claims := idClaims(js.URL)
+	claims["exp"] = testNow.Add(2 * jwksTTL).Unix()
+	token := signToken(t, key, rs256(testKid), claims)
+
+	if _, err := c.VerifyToken(context.Background(), token, "id"); err != nil {
+		t.Fatalf("first token: %v", err)
+	}
+
+	// A known kid is served from the cache until the TTL runs out
+	*now = testNow.Add(jwksTTL - time.Second)
+	if _, err := c.VerifyToken(context.Background(), token, "id"); err != nil {
+		t.Fatalf("token before TTL: %v", err)
+	}
+	if got := js.fetchCount(); got != 1 {
+		t.Fatalf("fetches before TTL = %d, want 1", got)
+	}
+
+	*now = testNow.Add(jwksTTL)
+	if _, err := c.VerifyToken(context.Background(), token, "id"); err != nil {
+		t.Fatalf("token after TTL: %v", err)
+	}
+	if got := js.fetchCount(); got != 2 {
+		t.Fatalf("fetches after TTL = %d, want 2", got)
+	}
+}
@@ -5,14 +5,16 @@ import (
 	"log"
 
 	"github.com/alexedwards/scs/v2"
-	"github.com/mcgigglepop/acnh-finder/server/internal/cognito"
-	"github.com/mcgigglepop/acnh-finder/server/internal/dynamodb"
+	"github.com/mcgigglepop/acnh-finder/server/internal/auth"
+	"github.com/mcgigglepop/acnh-finder/server/internal/repository"
 )
 
-type DynamoService struct {
-	UserProfile *dynamodb.DDBClient
-	Fish        *dynamodb.DDBClient
-	UserFish        *dynamodb.DDBClient
+// Stores holds the persistence backends used by the handlers
+type Stores struct {
+	UserProfile repository.UserProfileStore
+	Catalog     repository.CatalogStore
+	Collection  repository.CollectionStore
+	Villager    repository.VillagerStore
 }
 
 // AppConfig holds the application config
@@ -23,6 +25,6 @@ type AppConfig struct {
 	ErrorLog      *log.Logger
 	InProduction  bool
 	Session       *scs.SessionManager
-	CognitoClient *cognito.CognitoClient
-	Dynamo        *DynamoService
+	Auth          auth.Provider
+	Stores        *Stores
 }
@@ -0,0 +1,357 @@
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	sdkdynamodb "github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/mcgigglepop/acnh-finder/server/internal/availability"
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+	"github.com/mcgigglepop/acnh-finder/server/internal/repository"
+)
+
+// userKey is the partition key of a user's catch records
+func userKey(userID string) string {
+	return fmt.Sprintf("USER#%s", userID)
+}
+
+// categoryPrefix is the sort key prefix of a category's catch records,
+// e.g. "FISH#" or "SEA_CREATURE#"
+func categoryPrefix(category string) string {
+	return strings.ToUpper(category) + "#"
+}
+
+// getUserProgress reads the user's catch records for a category. Records of
+// every category share the table, keyed by PK "USER#<user id>" and SK
+// "<CATEGORY>#<collectible id>".
+func (c *DDBClient) getUserProgress(ctx context.Context, userID, category string) (map[string]models.UserCollectible, error) {
+	paginator := sdkdynamodb.NewQueryPaginator(c.db, &sdkdynamodb.QueryInput{
+		TableName:              aws.String(c.tableName),
+		KeyConditionExpression: aws.String("PK = :pk AND begins_with(SK, :prefix)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk":     &types.AttributeValueMemberS{Value: userKey(userID)},
+			":prefix": &types.AttributeValueMemberS{Value: categoryPrefix(category)},
+		},
+	})
+
+	progress := make(map[string]models.UserCollectible)
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range out.Items {
+			var record models.UserCollectible
+			if err := attributevalue.UnmarshalMap(item, &record); err != nil {
+				return nil, err
+			}
+			progress[record.ID] = record
+		}
+	}
+
+	return progress, nil
+}
+
+func (c *DDBClient) ListAvailable(ctx context.Context, category, userID string, month int, at availability.Clock, hemisphere string) ([]models.Collectible, error) {
+	all, err := c.ListCollectibles(ctx, category, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var available []models.Collectible
+	for _, item := range all {
+		ok, err := availability.Available(availability.Seasons(item, hemisphere), month, at)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check availability of %s %s: %w", category, item.ID, err)
+		}
+		if ok {
+			available = append(available, item)
+		}
+	}
+
+	return available, nil
+}
+
+// seedIndex is the position encoded in a seeded ID such as "12-koi", which
+// restores seed order from the lexically sorted range key
+func seedIndex(id string) int {
+	n, err := strconv.Atoi(strings.SplitN(id, "-", 2)[0])
+	if err != nil {
+		return math.MaxInt
+	}
+	return n
+}
+
+func (c *DDBClient) ListCollectibles(ctx context.Context, category, userID string) ([]models.Collectible, error) {
+	paginator := sdkdynamodb.NewQueryPaginator(c.db, &sdkdynamodb.QueryInput{
+		TableName:              aws.String(c.tableName),
+		KeyConditionExpression: aws.String("category = :category"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":category": &types.AttributeValueMemberS{Value: category},
+		},
+	})
+
+	var all []models.Collectible
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("query failed: %w", err)
+		}
+
+		var page []models.Collectible
+		if err := attributevalue.UnmarshalListOfMaps(out.Items, &page); err != nil {
+			return nil, fmt.Errorf("unmarshal failed: %w", err)
+		}
+		all = append(all, page...)
+	}
+
+	userProgress, err := c.collection.getUserProgress(ctx, userID, category)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s progress: %w", category, err)
+	}
+
+	for i, item := range all {
+		all[i] = item.WithProgress(userProgress[item.ID])
+	}
+	sort.SliceStable(all, func(i, j int) bool {
+		return seedIndex(all[i].ID) < seedIndex(all[j].ID)
+	})
+
+	return all, nil
+}
+
+func (c *DDBClient) CountCollectibles(ctx context.Context, category string) (int, error) {
+	paginator := sdkdynamodb.NewQueryPaginator(c.db, &sdkdynamodb.QueryInput{
+		TableName:              aws.String(c.tableName),
+		KeyConditionExpression: aws.String("category = :category"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":category": &types.AttributeValueMemberS{Value: category},
+		},
+		Select: types.SelectCount,
+	})
+
+	count := 0
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to count %s: %w", category, err)
+		}
+		count += int(out.Count)
+	}
+
+	return count, nil
+}
+
+func (c *DDBClient) GetCollectible(ctx context.Context, category, id string) (*models.Collectible, error) {
+	result, err := c.db.GetItem(ctx, &sdkdynamodb.GetItemInput{
+		TableName: aws.String(c.tableName),
+		Key: map[string]types.AttributeValue{
+			"category": &types.AttributeValueMemberS{Value: category},
+			"id":       &types.AttributeValueMemberS{Value: id},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s %s: %w", category, id, err)
+	}
+
+	if result.Item == nil {
+		return nil, repository.ErrNotFound
+	}
+
+	var item models.Collectible
+	if err := attributevalue.UnmarshalMap(result.Item, &item); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s %s: %w", category, id, err)
+	}
+
+	return &item, nil
+}
+
+// collectionItemKey is the primary key of a user's record for a collectible
+func collectionItemKey(userID, category, id string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"PK": &types.AttributeValueMemberS{Value: userKey(userID)},
+		"SK": &types.AttributeValueMemberS{Value: categoryPrefix(category) + id},
+	}
+}
+
+// putProgress upserts a user's record, setting each flag in flags to true.
+// A flag's "<flag>_at" timestamp is only written the first time it is set.
+func (c *DDBClient) putProgress(ctx context.Context, userID, category, id string, flags ...string) error {
+	expr := "SET user_id = :user_id, category = :category, id = :id"
+	for _, flag := range flags {
+		expr += fmt.Sprintf(", %[1]s = :true, %[1]s_at = if_not_exists(%[1]s_at, :now)", flag)
+	}
+
+	_, err := c.db.UpdateItem(ctx, &sdkdynamodb.UpdateItemInput{
+		TableName:        aws.String(c.tableName),
+		Key:              collectionItemKey(userID, category, id),
+		UpdateExpression: aws.String(expr),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":user_id":  &types.AttributeValueMemberS{Value: userID},
+			":category": &types.AttributeValueMemberS{Value: category},
+			":id":       &types.AttributeValueMemberS{Value: id},
+			":true":     &types.AttributeValueMemberBOOL{Value: true},
+			":now":      &types.AttributeValueMemberS{Value: time.Now().UTC().Format(time.RFC3339Nano)},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("UpdateItem failed: %w", err)
+	}
+	return nil
+}
+
+func (c *DDBClient) PutCaught(ctx context.Context, userID, category, id string) error {
+	return c.putProgress(ctx, userID, category, id, "caught")
+}
+
+func (c *DDBClient) DeleteCaught(ctx context.Context, userID, category, id string) error {
+	_, err := c.db.DeleteItem(ctx, &sdkdynamodb.DeleteItemInput{
+		TableName: aws.String(c.tableName),
+		Key:       collectionItemKey(userID, category, id),
+	})
+	if err != nil {
+		return fmt.Errorf("DeleteItem failed: %w", err)
+	}
+	return nil
+}
+
+func (c *DDBClient) PutDonated(ctx context.Context, userID, category, id string) error {
+	return c.putProgress(ctx, userID, category, id, "caught", "donated")
+}
+
+func (c *DDBClient) DeleteDonated(ctx context.Context, userID, category, id string) error {
+	_, err := c.db.UpdateItem(ctx, &sdkdynamodb.UpdateItemInput{
+		TableName:           aws.String(c.tableName),
+		Key:                 collectionItemKey(userID, category, id),
+		UpdateExpression:    aws.String("SET donated = :false REMOVE donated_at"),
+		ConditionExpression: aws.String("attribute_exists(PK)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":false": &types.AttributeValueMemberBOOL{Value: false},
+		},
+	})
+
+	// Nothing was caught, so there is no donation to withdraw
+	var missing *types.ConditionalCheckFailedException
+	if errors.As(err, &missing) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("UpdateItem failed: %w", err)
+	}
+	return nil
+}
+
+func (c *DDBClient) CountCaught(ctx context.Context, userID, category string) (int, error) {
+	count, err := c.countProgress(ctx, userID, category, "")
+	if err != nil {
+		return 0, fmt.Errorf("failed to count caught %s: %w", category, err)
+	}
+	return count, nil
+}
+
+func (c *DDBClient) CountDonated(ctx context.Context, userID, category string) (int, error) {
+	count, err := c.countProgress(ctx, userID, category, "donated = :true")
+	if err != nil {
+		return 0, fmt.Errorf("failed to count donated %s: %w", category, err)
+	}
+	return count, nil
+}
+
+func (c *DDBClient) SetDuplicates(ctx context.Context, userID, category, id string, count int) error {
+	if count > 0 {
+		if err := c.putProgress(ctx, userID, category, id, "caught"); err != nil {
+			return err
+		}
+	}
+
+	_, err := c.db.UpdateItem(ctx, &sdkdynamodb.UpdateItemInput{
+		TableName:           aws.String(c.tableName),
+		Key:                 collectionItemKey(userID, category, id),
+		UpdateExpression:    aws.String("SET duplicates = :count"),
+		ConditionExpression: aws.String("attribute_exists(PK)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":count": &types.AttributeValueMemberN{Value: strconv.Itoa(max(count, 0))},
+		},
+	})
+
+	// Clearing the duplicates of something never assessed is a no-op
+	var missing *types.ConditionalCheckFailedException
+	if errors.As(err, &missing) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("UpdateItem failed: %w", err)
+	}
+	return nil
+}
+
+func (c *DDBClient) CountDuplicates(ctx context.Context, userID, category string) (int, error) {
+	paginator := sdkdynamodb.NewQueryPaginator(c.db, &sdkdynamodb.QueryInput{
+		TableName:              aws.String(c.tableName),
+		KeyConditionExpression: aws.String("PK = :pk AND begins_with(SK, :prefix)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk":     &types.AttributeValueMemberS{Value: userKey(userID)},
+			":prefix": &types.AttributeValueMemberS{Value: categoryPrefix(category)},
+		},
+		ProjectionExpression: aws.String("duplicates"),
+	})
+
+	total := 0
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to count duplicate %s: %w", category, err)
+		}
+
+		for _, item := range out.Items {
+			var record models.UserCollectible
+			if err := attributevalue.UnmarshalMap(item, &record); err != nil {
+				return 0, fmt.Errorf("failed to count duplicate %s: %w", category, err)
+			}
+			total += record.Duplicates
+		}
+	}
+
+	return total, nil
+}
+
+// countProgress counts the user's records in the category, optionally
+// narrowed by a filter expression that may reference :true
+func (c *DDBClient) countProgress(ctx context.Context, userID, category, filter string) (int, error) {
+	input := &sdkdynamodb.QueryInput{
+		TableName:              aws.String(c.tableName),
+		KeyConditionExpression: aws.String("PK = :pk AND begins_with(SK, :prefix)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk":     &types.AttributeValueMemberS{Value: userKey(userID)},
+			":prefix": &types.AttributeValueMemberS{Value: categoryPrefix(category)},
+		},
+		Select: types.SelectCount,
+	}
+	if filter != "" {
+		input.FilterExpression = aws.String(filter)
+		input.ExpressionAttributeValues[":true"] = &types.AttributeValueMemberBOOL{Value: true}
+	}
+
+	paginator := sdkdynamodb.NewQueryPaginator(c.db, input)
+
+	count := 0
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return 0, err
+		}
+		count += int(out.Count)
+	}
+
+	return count, nil
+}
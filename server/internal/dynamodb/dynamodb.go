@@ -4,7 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -12,13 +12,23 @@ import (
 	sdkdynamodb "github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+	"github.com/mcgigglepop/acnh-finder/server/internal/repository"
 )
 
 type DDBClient struct {
 	db        *sdkdynamodb.Client
 	tableName string
+	// collection is the catch record table a catalog client merges into
+	// the entries it lists
+	collection *DDBClient
 }
 
+var (
+	_ repository.UserProfileStore = (*DDBClient)(nil)
+	_ repository.CatalogStore     = (*DDBClient)(nil)
+	_ repository.CollectionStore  = (*DDBClient)(nil)
+)
+
 func NewAppClient(cfg aws.Config, tableName string) *DDBClient {
 	return &DDBClient{
 		db:        sdkdynamodb.NewFromConfig(cfg),
@@ -26,6 +36,44 @@ func NewAppClient(cfg aws.Config, tableName string) *DDBClient {
 	}
 }
 
+// NewCatalogClient creates a catalog client that reads users' progress from
+// the collection client's table
+func NewCatalogClient(cfg aws.Config, tableName string, collection *DDBClient) *DDBClient {
+	c := NewAppClient(cfg, tableName)
+	c.collection = collection
+	return c
+}
+
+func (c *DDBClient) CreateUserProfile(ctx context.Context, userSub string) error {
+	now := time.Now().UTC()
+	item, err := attributevalue.MarshalMap(models.User{
+		UserID:     userSub,
+		Hemisphere: models.HemisphereUnset,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal profile: %w", err)
+	}
+
+	_, err = c.db.PutItem(ctx, &sdkdynamodb.PutItemInput{
+		TableName:           aws.String(c.tableName),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(user_id)"),
+	})
+
+	// The profile already exists, which is the common case on later logins
+	var exists *types.ConditionalCheckFailedException
+	if errors.As(err, &exists) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create profile: %w", err)
+	}
+
+	return nil
+}
+
 func (c *DDBClient) GetUserProfile(ctx context.Context, userSub string) (*models.User, error) {
 	input := &sdkdynamodb.GetItemInput{
 		TableName: aws.String(c.tableName),
@@ -40,7 +88,7 @@ func (c *DDBClient) GetUserProfile(ctx context.Context, userSub string) (*models
 	}
 
 	if result.Item == nil {
-		return nil, errors.New("user not found")
+		return nil, repository.ErrNotFound
 	}
 
 	var user models.User
@@ -57,9 +105,10 @@ func (c *DDBClient) UpdateUserHemisphere(ctx context.Context, userSub string, he
 		Key: map[string]types.AttributeValue{
 			"user_id": &types.AttributeValueMemberS{Value: userSub},
 		},
-		UpdateExpression: aws.String("SET hemisphere = :h"),
+		UpdateExpression: aws.String("SET hemisphere = :h, updated_at = :u"),
 		ExpressionAttributeValues: map[string]types.AttributeValue{
 			":h": &types.AttributeValueMemberS{Value: hemisphere},
+			":u": &types.AttributeValueMemberS{Value: time.Now().UTC().Format(time.RFC3339Nano)},
 		},
 		ReturnValues: types.ReturnValueUpdatedNew,
 	}
@@ -72,164 +121,24 @@ func (c *DDBClient) UpdateUserHemisphere(ctx context.Context, userSub string, he
 	return nil
 }
 
-func containsInt(slice []int, val int) bool {
-	for _, v := range slice {
-		if v == val {
-			return true
-		}
-	}
-	return false
-}
-
-func isTimeInRange(current, start, end string) bool {
-	layout := "15:04"
-	now, _ := time.Parse(layout, current)
-	from, _ := time.Parse(layout, start)
-	to, _ := time.Parse(layout, end)
-
-	if from.Before(to) {
-		return now.After(from) && now.Before(to)
-	}
-	// Overnight wraparound
-	return now.After(from) || now.Before(to)
-}
-
-func isFishAvailable(seasons []models.SeasonalAvailability, month int, hour string) bool {
-	for _, s := range seasons {
-		if containsInt(s.Months, month) {
-			for _, tr := range s.TimeRanges {
-				if isTimeInRange(hour, tr.Start, tr.End) {
-					return true
-				}
-			}
-		}
-	}
-	return false
-}
-
-func (c *DDBClient) getUserCaughtFishMap(ctx context.Context, userID string) (map[string]bool, error) {
-	out, err := c.db.Query(ctx, &sdkdynamodb.QueryInput{
-		TableName:              aws.String("UserFish"),
-		KeyConditionExpression: aws.String("PK = :pk"),
+func (c *DDBClient) UpdateUserClock(ctx context.Context, userSub string, timeZone string, clockOffset int) error {
+	input := &sdkdynamodb.UpdateItemInput{
+		TableName: aws.String(c.tableName),
+		Key: map[string]types.AttributeValue{
+			"user_id": &types.AttributeValueMemberS{Value: userSub},
+		},
+		UpdateExpression: aws.String("SET time_zone = :z, clock_offset = :o, updated_at = :u"),
 		ExpressionAttributeValues: map[string]types.AttributeValue{
-			":pk": &types.AttributeValueMemberS{Value: fmt.Sprintf("USER#%s", userID)},
+			":z": &types.AttributeValueMemberS{Value: timeZone},
+			":o": &types.AttributeValueMemberN{Value: strconv.Itoa(clockOffset)},
+			":u": &types.AttributeValueMemberS{Value: time.Now().UTC().Format(time.RFC3339Nano)},
 		},
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	caughtMap := make(map[string]bool)
-	for _, item := range out.Items {
-		var record struct {
-			SK     string `dynamodbav:"SK"`
-			Caught bool   `dynamodbav:"Caught"`
-		}
-		if err := attributevalue.UnmarshalMap(item, &record); err != nil {
-			return nil, err
-		}
-
-		// Extract fish ID from SK like "FISH#abc123"
-		parts := strings.Split(record.SK, "#")
-		if len(parts) == 2 {
-			fishID := parts[1]
-			caughtMap[fishID] = record.Caught
-		}
-	}
-
-	return caughtMap, nil
-}
-
-func (c *DDBClient) ListAvailableFish(ctx context.Context, userID string, month int, hour string, hemisphere string) ([]models.Fish, error) {
-	// 1. Scan all fish 
-	out, err := c.db.Scan(ctx, &sdkdynamodb.ScanInput{
-		TableName: aws.String(c.tableName),
-	})
-	if err != nil {
-		return nil, fmt.Errorf("scan failed: %w", err)
-	}
-
-	var allFish []models.Fish
-	if err := attributevalue.UnmarshalListOfMaps(out.Items, &allFish); err != nil {
-		return nil, fmt.Errorf("unmarshal failed: %w", err)
 	}
 
-	// 2. Fetch user caught fish map
-	userCaughtMap, err := c.getUserCaughtFishMap(ctx, userID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch caught fish map: %w", err)
-	}
-
-	// 3. Filter and merge
-	var availableFish []models.Fish
-	for _, fish := range allFish {
-		var seasons []models.SeasonalAvailability
-		if hemisphere == "north" {
-			seasons = fish.NorthAvailability
-		} else {
-			seasons = fish.SouthAvailability
-		}
-
-		if isFishAvailable(seasons, month, hour) {
-			// Check if user caught this fish
-			fish.Caught = userCaughtMap[fish.FishID]
-			availableFish = append(availableFish, fish)
-		}
-	}
-
-	return availableFish, nil
-}
-
-func (c *DDBClient) PutCaughtFish(ctx context.Context, userID, fishID string) error {
-	item := map[string]types.AttributeValue{
-		"PK":     &types.AttributeValueMemberS{Value: fmt.Sprintf("USER#%s", userID)},
-		"SK":     &types.AttributeValueMemberS{Value: fmt.Sprintf("FISH#%s", fishID)},
-		"user_id": &types.AttributeValueMemberS{Value: userID},
-		"fish_id": &types.AttributeValueMemberS{Value: fishID},
-		"caught":  &types.AttributeValueMemberBOOL{Value: true},
-	}
-
-	_, err := c.db.PutItem(ctx, &sdkdynamodb.PutItemInput{
-		TableName: aws.String("UserFish"),
-		Item:      item,
-	})
-
+	_, err := c.db.UpdateItem(ctx, input)
 	if err != nil {
-		return fmt.Errorf("PutItem failed: %w", err)
-	}
-	return nil
-}
-
-func (c *DDBClient) DeleteCaughtFish(ctx context.Context, userID, fishID string) error {
-	key := map[string]types.AttributeValue{
-		"PK": &types.AttributeValueMemberS{Value: fmt.Sprintf("USER#%s", userID)},
-		"SK": &types.AttributeValueMemberS{Value: fmt.Sprintf("FISH#%s", fishID)},
+		return fmt.Errorf("failed to update clock: %w", err)
 	}
 
-	_, err := c.db.DeleteItem(ctx, &sdkdynamodb.DeleteItemInput{
-		TableName: aws.String(c.tableName),
-		Key:       key,
-	})
-	if err != nil {
-		return fmt.Errorf("DeleteItem failed: %w", err)
-	}
 	return nil
 }
-
-func (c *DDBClient) CountCaughtFish(ctx context.Context, userID string) (int, error) {
-	pk := fmt.Sprintf("USER#%s", userID)
-
-	out, err := c.db.Query(ctx, &sdkdynamodb.QueryInput{
-		TableName:              aws.String(c.tableName),
-		KeyConditionExpression: aws.String("PK = :pk"),
-		ExpressionAttributeValues: map[string]types.AttributeValue{
-			":pk": &types.AttributeValueMemberS{Value: pk},
-		},
-		Select: types.SelectCount, // 💡 Just return the count, no item data
-	})
-	if err != nil {
-		return 0, fmt.Errorf("failed to count caught fish: %w", err)
-	}
-
-	return int(out.Count), nil
-}
@@ -0,0 +1,145 @@
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	sdkdynamodb "github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+	"github.com/mcgigglepop/acnh-finder/server/internal/repository"
+)
+
+var _ repository.VillagerStore = (*DDBClient)(nil)
+
+func (c *DDBClient) ListVillagers(ctx context.Context) ([]models.Villager, error) {
+	paginator := sdkdynamodb.NewScanPaginator(c.db, &sdkdynamodb.ScanInput{
+		TableName: aws.String(c.tableName),
+	})
+
+	var villagers []models.Villager
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("scan failed: %w", err)
+		}
+
+		var page []models.Villager
+		if err := attributevalue.UnmarshalListOfMaps(out.Items, &page); err != nil {
+			return nil, fmt.Errorf("unmarshal failed: %w", err)
+		}
+		villagers = append(villagers, page...)
+	}
+
+	sort.SliceStable(villagers, func(i, j int) bool {
+		return seedIndex(villagers[i].VillagerID) < seedIndex(villagers[j].VillagerID)
+	})
+
+	return villagers, nil
+}
+
+func (c *DDBClient) GetVillager(ctx context.Context, villagerID string) (*models.Villager, error) {
+	result, err := c.db.GetItem(ctx, &sdkdynamodb.GetItemInput{
+		TableName: aws.String(c.tableName),
+		Key: map[string]types.AttributeValue{
+			"villager_id": &types.AttributeValueMemberS{Value: villagerID},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get villager: %w", err)
+	}
+
+	if result.Item == nil {
+		return nil, repository.ErrNotFound
+	}
+
+	var villager models.Villager
+	if err := attributevalue.UnmarshalMap(result.Item, &villager); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal villager: %w", err)
+	}
+
+	return &villager, nil
+}
+
+func (c *DDBClient) MoveInVillager(ctx context.Context, userSub, villagerID string) error {
+	return c.updateResidents(ctx, userSub, func(u *models.User, now time.Time) error {
+		return u.MoveIn(villagerID, now)
+	})
+}
+
+func (c *DDBClient) MoveOutVillager(ctx context.Context, userSub, villagerID string) error {
+	return c.updateResidents(ctx, userSub, func(u *models.User, now time.Time) error {
+		return u.MoveOut(villagerID, now)
+	})
+}
+
+// updateResidents reads the profile, applies the roster move and writes the
+// history back, guarded on updated_at so concurrent moves can't overwrite
+// each other. A lost race returns repository.ErrConflict.
+func (c *DDBClient) updateResidents(ctx context.Context, userSub string, move func(u *models.User, now time.Time) error) error {
+	key := map[string]types.AttributeValue{
+		"user_id": &types.AttributeValueMemberS{Value: userSub},
+	}
+
+	result, err := c.db.GetItem(ctx, &sdkdynamodb.GetItemInput{
+		TableName:      aws.String(c.tableName),
+		Key:            key,
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get item: %w", err)
+	}
+	if result.Item == nil {
+		return repository.ErrNotFound
+	}
+
+	var user models.User
+	if err := attributevalue.UnmarshalMap(result.Item, &user); err != nil {
+		return fmt.Errorf("failed to unmarshal item: %w", err)
+	}
+
+	now := time.Now().UTC()
+	if err := move(&user, now); err != nil {
+		return err
+	}
+
+	history, err := attributevalue.Marshal(user.ResidentHistory)
+	if err != nil {
+		return fmt.Errorf("failed to marshal resident history: %w", err)
+	}
+
+	values := map[string]types.AttributeValue{
+		":h":   history,
+		":now": &types.AttributeValueMemberS{Value: now.Format(time.RFC3339Nano)},
+	}
+	// Compare against the stored string rather than a reformatted time, since
+	// the post-confirmation lambda writes timestamps in its own format
+	condition := "attribute_not_exists(updated_at)"
+	if prev, ok := result.Item["updated_at"]; ok {
+		condition = "updated_at = :prev"
+		values[":prev"] = prev
+	}
+
+	_, err = c.db.UpdateItem(ctx, &sdkdynamodb.UpdateItemInput{
+		TableName:                 aws.String(c.tableName),
+		Key:                       key,
+		UpdateExpression:          aws.String("SET resident_history = :h, updated_at = :now"),
+		ConditionExpression:       aws.String(condition),
+		ExpressionAttributeValues: values,
+	})
+
+	var changed *types.ConditionalCheckFailedException
+	if errors.As(err, &changed) {
+		return repository.ErrConflict
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update residents: %w", err)
+	}
+
+	return nil
+}
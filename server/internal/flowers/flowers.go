@@ -0,0 +1,452 @@
+// Package flowers models flower breeding. Every species has three or four
+// genes, each holding zero, one or two dominant alleles; the colour of a
+// flower is a fixed function of its genes. Breeding two flowers passes one
+// random allele per gene from each parent to the offspring.
+package flowers
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Species
+const (
+	Rose       Species = "rose"
+	Tulip      Species = "tulip"
+	Pansy      Species = "pansy"
+	Cosmos     Species = "cosmos"
+	Lily       Species = "lily"
+	Hyacinth   Species = "hyacinth"
+	Windflower Species = "windflower"
+	Mum        Species = "mum"
+)
+
+// AllSpecies lists every breedable species
+var AllSpecies = []Species{Rose, Tulip, Pansy, Cosmos, Lily, Hyacinth, Windflower, Mum}
+
+var (
+	ErrUnknownSpecies  = errors.New("unknown species")
+	ErrInvalidGenotype = errors.New("invalid genotype")
+	ErrSpeciesMismatch = errors.New("parents are different species")
+)
+
+// Species is a breedable flower species
+type Species string
+
+// Seed is a genotype sold as a seed bag
+type Seed struct {
+	Colour   string
+	Genotype Genotype
+}
+
+// species describes one species' genetics
+type species struct {
+	// genes holds the dominant allele letter of each gene, in order
+	genes string
+	// colours maps a genotype, read as a base-3 number of its gene counts,
+	// to the flower's colour
+	colours []string
+	// seeds are the seed bag genotypes, as gene counts
+	seeds map[string][]uint8
+}
+
+var speciesInfo = map[Species]species{
+	Rose: {
+		genes: "RYWS",
+		colours: []string{
+			"Purple", "Purple", "Purple", // rr yy ww
+			"White", "White", "White", // rr yy Ww
+			"White", "White", "White", // rr yy WW
+			"Purple", "Purple", "Purple", // rr Yy ww
+			"White", "White", "White", // rr Yy Ww
+			"Yellow", "Yellow", "Yellow", // rr Yy WW
+			"White", "White", "White", // rr YY ww
+			"Yellow", "Yellow", "Yellow", // rr YY Ww
+			"Yellow", "Yellow", "Yellow", // rr YY WW
+			"Red", "Pink", "Purple", // Rr yy ww
+			"Red", "Pink", "White", // Rr yy Ww
+			"Red", "Pink", "White", // Rr yy WW
+			"Red", "Pink", "Purple", // Rr Yy ww
+			"Red", "Pink", "White", // Rr Yy Ww
+			"Orange", "Yellow", "Yellow", // Rr Yy WW
+			"Red", "Pink", "White", // Rr YY ww
+			"Orange", "Yellow", "Yellow", // Rr YY Ww
+			"Orange", "Yellow", "Yellow", // Rr YY WW
+			"Black", "Red", "Pink", // RR yy ww
+			"Black", "Red", "Pink", // RR yy Ww
+			"Black", "Red", "Pink", // RR yy WW
+			"Black", "Red", "Purple", // RR Yy ww
+			"Red", "Red", "White", // RR Yy Ww
+			"Orange", "Orange", "Yellow", // RR Yy WW
+			"Blue", "Red", "White", // RR YY ww
+			"Orange", "Orange", "Yellow", // RR YY Ww
+			"Orange", "Orange", "Yellow", // RR YY WW
+		},
+		seeds: map[string][]uint8{
+			"Red":    {2, 0, 2, 1},
+			"Yellow": {0, 2, 2, 0},
+			"White":  {0, 0, 1, 0},
+		},
+	},
+	Tulip: {
+		genes: "RYS",
+		colours: []string{
+			"White", "White", "White", // rr yy
+			"Yellow", "Yellow", "White", // rr Yy
+			"Yellow", "Yellow", "Yellow", // rr YY
+			"Red", "Pink", "White", // Rr yy
+			"Orange", "Yellow", "Yellow", // Rr Yy
+			"Orange", "Yellow", "Yellow", // Rr YY
+			"Black", "Red", "Red", // RR yy
+			"Black", "Red", "Red", // RR Yy
+			"Purple", "Purple", "Purple", // RR YY
+		},
+		seeds: map[string][]uint8{
+			"Red":    {2, 0, 1},
+			"Yellow": {0, 2, 0},
+			"White":  {0, 0, 1},
+		},
+	},
+	Pansy: {
+		genes: "RYW",
+		colours: []string{
+			"Blue", "White", "White", // rr yy
+			"Blue", "Yellow", "Yellow", // rr Yy
+			"Yellow", "Yellow", "Yellow", // rr YY
+			"Blue", "Red", "Red", // Rr yy
+			"Orange", "Orange", "Orange", // Rr Yy
+			"Yellow", "Yellow", "Yellow", // Rr YY
+			"Purple", "Red", "Red", // RR yy
+			"Purple", "Red", "Red", // RR Yy
+			"Purple", "Orange", "Orange", // RR YY
+		},
+		seeds: map[string][]uint8{
+			"Red":    {2, 0, 2},
+			"Yellow": {0, 2, 2},
+			"White":  {0, 0, 1},
+		},
+	},
+	Cosmos: {
+		genes: "RYS",
+		colours: []string{
+			"White", "White", "White", // rr yy
+			"Yellow", "Yellow", "White", // rr Yy
+			"Yellow", "Yellow", "Yellow", // rr YY
+			"Pink", "Pink", "Pink", // Rr yy
+			"Orange", "Orange", "Pink", // Rr Yy
+			"Orange", "Orange", "Orange", // Rr YY
+			"Red", "Red", "Red", // RR yy
+			"Orange", "Orange", "Red", // RR Yy
+			"Black", "Black", "Red", // RR YY
+		},
+		seeds: map[string][]uint8{
+			"Red":    {2, 0, 0},
+			"Yellow": {0, 2, 1},
+			"White":  {0, 0, 1},
+		},
+	},
+	Lily: {
+		genes: "RYS",
+		colours: []string{
+			"White", "White", "White", // rr yy
+			"Yellow", "White", "White", // rr Yy
+			"Yellow", "Yellow", "White", // rr YY
+			"Red", "Pink", "White", // Rr yy
+			"Orange", "Yellow", "Yellow", // Rr Yy
+			"Orange", "Yellow", "Yellow", // Rr YY
+			"Black", "Red", "Pink", // RR yy
+			"Black", "Red", "Pink", // RR Yy
+			"Orange", "Orange", "White", // RR YY
+		},
+		seeds: map[string][]uint8{
+			"Red":    {2, 0, 1},
+			"Yellow": {0, 2, 0},
+			"White":  {0, 0, 2},
+		},
+	},
+	Hyacinth: {
+		genes: "RYW",
+		colours: []string{
+			"Blue", "White", "White", // rr yy
+			"White", "Yellow", "Yellow", // rr Yy
+			"Yellow", "Yellow", "Yellow", // rr YY
+			"White", "Pink", "Red", // Rr yy
+			"Yellow", "Yellow", "Orange", // Rr Yy
+			"Yellow", "Yellow", "Orange", // Rr YY
+			"Red", "Red", "Red", // RR yy
+			"Red", "Red", "Blue", // RR Yy
+			"Purple", "Purple", "Purple", // RR YY
+		},
+		seeds: map[string][]uint8{
+			"Red":    {2, 0, 1},
+			"Yellow": {0, 2, 2},
+			"White":  {0, 0, 1},
+		},
+	},
+	Windflower: {
+		genes: "ROW",
+		colours: []string{
+			"Blue", "White", "White", // rr oo
+			"Blue", "Orange", "Orange", // rr Oo
+			"Orange", "Orange", "Orange", // rr OO
+			"Blue", "Red", "Red", // Rr oo
+			"Pink", "Pink", "Pink", // Rr Oo
+			"Pink", "Orange", "Orange", // Rr OO
+			"Purple", "Red", "Red", // RR oo
+			"Purple", "Red", "Red", // RR Oo
+			"Purple", "Pink", "Pink", // RR OO
+		},
+		seeds: map[string][]uint8{
+			"Red":    {2, 0, 2},
+			"Orange": {0, 2, 2},
+			"White":  {0, 0, 1},
+		},
+	},
+	Mum: {
+		genes: "RYW",
+		colours: []string{
+			"Purple", "White", "White", // rr yy
+			"White", "Yellow", "Yellow", // rr Yy
+			"Yellow", "Yellow", "Yellow", // rr YY
+			"Pink", "Pink", "Pink", // Rr yy
+			"Pink", "Red", "Yellow", // Rr Yy
+			"Purple", "Purple", "Purple", // Rr YY
+			"Red", "Red", "Red", // RR yy
+			"Red", "Purple", "Purple", // RR Yy
+			"Red", "Green", "Green", // RR YY
+		},
+		seeds: map[string][]uint8{
+			"Red":    {2, 0, 2},
+			"Yellow": {0, 2, 2},
+			"White":  {0, 0, 1},
+		},
+	},
+}
+
+// ParseSpecies returns the species with the given name, ignoring case
+func ParseSpecies(name string) (Species, error) {
+	s := Species(strings.ToLower(strings.TrimSpace(name)))
+	if _, ok := speciesInfo[s]; !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownSpecies, name)
+	}
+	return s, nil
+}
+
+// Genes returns the dominant allele letter of each of the species' genes,
+// e.g. "RYWS" for roses
+func (s Species) Genes() string {
+	return speciesInfo[s].genes
+}
+
+// Colours returns every colour the species comes in, sorted
+func (s Species) Colours() []string {
+	seen := map[string]bool{}
+	var colours []string
+	for _, c := range speciesInfo[s].colours {
+		if !seen[c] {
+			seen[c] = true
+			colours = append(colours, c)
+		}
+	}
+	sort.Strings(colours)
+	return colours
+}
+
+// Seeds returns the species' seed bag genotypes, sorted by colour
+func (s Species) Seeds() []Seed {
+	var seeds []Seed
+	for colour, genes := range speciesInfo[s].seeds {
+		g := Genotype{Species: s}
+		copy(g.genes[:], genes)
+		seeds = append(seeds, Seed{Colour: colour, Genotype: g})
+	}
+	sort.Slice(seeds, func(i, j int) bool { return seeds[i].Colour < seeds[j].Colour })
+	return seeds
+}
+
+// Genotype is a flower's genes, stored as the number of dominant alleles
+// (0 to 2) of each gene
+type Genotype struct {
+	Species Species
+	genes   [4]uint8
+}
+
+// ParseGenotype parses a genotype written either as allele pairs, e.g.
+// "RrYyWWss", or as dominant allele counts, e.g. "1120". Allele pairs may
+// be separated by spaces or dashes.
+func ParseGenotype(s Species, text string) (Genotype, error) {
+	info, ok := speciesInfo[s]
+	if !ok {
+		return Genotype{}, fmt.Errorf("%w: %q", ErrUnknownSpecies, s)
+	}
+
+	g := Genotype{Species: s}
+	compact := strings.NewReplacer(" ", "", "-", "").Replace(text)
+	switch len(compact) {
+	case len(info.genes):
+		for i, c := range compact {
+			if c < '0' || c > '2' {
+				return Genotype{}, fmt.Errorf("%w: %q", ErrInvalidGenotype, text)
+			}
+			g.genes[i] = uint8(c - '0')
+		}
+	case 2 * len(info.genes):
+		for i := range len(info.genes) {
+			dominant := info.genes[i]
+			for _, c := range compact[2*i : 2*i+2] {
+				switch byte(c) {
+				case dominant:
+					g.genes[i]++
+				case dominant + 'a' - 'A':
+				default:
+					return Genotype{}, fmt.Errorf("%w: %q", ErrInvalidGenotype, text)
+				}
+			}
+		}
+	default:
+		return Genotype{}, fmt.Errorf("%w: %q", ErrInvalidGenotype, text)
+	}
+	return g, nil
+}
+
+// String returns the genotype as allele pairs, e.g. "RrYyWWss"
+func (g Genotype) String() string {
+	var b strings.Builder
+	for i := range len(g.Species.Genes()) {
+		upper := g.Species.Genes()[i]
+		lower := upper + 'a' - 'A'
+		switch g.genes[i] {
+		case 0:
+			b.WriteByte(lower)
+			b.WriteByte(lower)
+		case 1:
+			b.WriteByte(upper)
+			b.WriteByte(lower)
+		default:
+			b.WriteByte(upper)
+			b.WriteByte(upper)
+		}
+	}
+	return b.String()
+}
+
+// Digits returns the genotype as dominant allele counts, e.g. "1120"
+func (g Genotype) Digits() string {
+	var b strings.Builder
+	for i := range len(g.Species.Genes()) {
+		b.WriteByte('0' + g.genes[i])
+	}
+	return b.String()
+}
+
+// MarshalText encodes the genotype as allele pairs
+func (g Genotype) MarshalText() ([]byte, error) {
+	return []byte(g.String()), nil
+}
+
+// index returns the genotype read as a base-3 number of its gene counts
+func (g Genotype) index() int {
+	n := 0
+	for i := range len(g.Species.Genes()) {
+		n = 3*n + int(g.genes[i])
+	}
+	return n
+}
+
+// Colour returns the colour of a flower with the genotype
+func (g Genotype) Colour() string {
+	return speciesInfo[g.Species].colours[g.index()]
+}
+
+// Outcome is one possible offspring genotype of a cross
+type Outcome struct {
+	Genotype    Genotype
+	Colour      string
+	Probability float64
+}
+
+// ColourOdds is the chance of a cross producing a colour
+type ColourOdds struct {
+	Colour      string
+	Probability float64
+}
+
+// geneOdds returns the chance, in quarters, of an offspring getting 0, 1
+// or 2 dominant alleles from parents with a and b dominant alleles
+func geneOdds(a, b uint8) [3]int {
+	// each parent passes on a dominant allele with probability count/2
+	pa, pb := int(a), int(b)
+	return [3]int{
+		(2 - pa) * (2 - pb),
+		pa*(2-pb) + pb*(2-pa),
+		pa * pb,
+	}
+}
+
+// Breed returns every possible offspring of the two parents with its
+// probability, most likely first
+func Breed(a, b Genotype) ([]Outcome, error) {
+	if a.Species != b.Species {
+		return nil, ErrSpeciesMismatch
+	}
+	if _, ok := speciesInfo[a.Species]; !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownSpecies, a.Species)
+	}
+
+	// weights are in units of 1/4 per gene
+	weights := map[Genotype]int{{Species: a.Species}: 1}
+	total := 1
+	for i := range len(a.Species.Genes()) {
+		odds := geneOdds(a.genes[i], b.genes[i])
+		next := make(map[Genotype]int, 3*len(weights))
+		for g, w := range weights {
+			for count, p := range odds {
+				if p == 0 {
+					continue
+				}
+				g.genes[i] = uint8(count)
+				next[g] += w * p
+			}
+		}
+		weights = next
+		total *= 4
+	}
+
+	outcomes := make([]Outcome, 0, len(weights))
+	for g, w := range weights {
+		outcomes = append(outcomes, Outcome{
+			Genotype:    g,
+			Colour:      g.Colour(),
+			Probability: float64(w) / float64(total),
+		})
+	}
+	sort.Slice(outcomes, func(i, j int) bool {
+		if outcomes[i].Probability != outcomes[j].Probability {
+			return outcomes[i].Probability > outcomes[j].Probability
+		}
+		return outcomes[i].Genotype.index() < outcomes[j].Genotype.index()
+	})
+	return outcomes, nil
+}
+
+// Colours totals the outcomes of a cross by colour, most likely first
+func Colours(outcomes []Outcome) []ColourOdds {
+	totals := map[string]float64{}
+	for _, o := range outcomes {
+		totals[o.Colour] += o.Probability
+	}
+
+	odds := make([]ColourOdds, 0, len(totals))
+	for colour, p := range totals {
+		odds = append(odds, ColourOdds{Colour: colour, Probability: p})
+	}
+	sort.Slice(odds, func(i, j int) bool {
+		if odds[i].Probability != odds[j].Probability {
+			return odds[i].Probability > odds[j].Probability
+		}
+		return odds[i].Colour < odds[j].Colour
+	})
+	return odds
+}
@@ -0,0 +1,207 @@
+package flowers
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func mustParse(t *testing.T, s Species, text string) Genotype {
+	t.Helper()
+	g, err := ParseGenotype(s, text)
+	if err != nil {
+		t.Fatalf("ParseGenotype(%s, %q): %v", s, text, err)
+	}
+	return g
+}
+
+// colourOdds returns the chance of the cross producing colour
+func colourOdds(t *testing.T, a, b Genotype, colour string) float64 {
+	t.Helper()
+	outcomes, err := Breed(a, b)
+	if err != nil {
+		t.Fatalf("Breed(%s, %s): %v", a, b, err)
+	}
+	for _, odds := range Colours(outcomes) {
+		if odds.Colour == colour {
+			return odds.Probability
+		}
+	}
+	return 0
+}
+
+func TestSeeds(t *testing.T) {
+	tests := []struct {
+		species Species
+		want    map[string]string
+	}{
+		{Rose, map[string]string{"Red": "RRyyWWSs", "Yellow": "rrYYWWss", "White": "rryyWwss"}},
+		{Tulip, map[string]string{"Red": "RRyySs", "Yellow": "rrYYss", "White": "rryySs"}},
+		{Pansy, map[string]string{"Red": "RRyyWW", "Yellow": "rrYYWW", "White": "rryyWw"}},
+		{Cosmos, map[string]string{"Red": "RRyyss", "Yellow": "rrYYSs", "White": "rryySs"}},
+		{Lily, map[string]string{"Red": "RRyySs", "Yellow": "rrYYss", "White": "rryySS"}},
+		{Hyacinth, map[string]string{"Red": "RRyyWw", "Yellow": "rrYYWW", "White": "rryyWw"}},
+		{Windflower, map[string]string{"Red": "RRooWW", "Orange": "rrOOWW", "White": "rrooWw"}},
+		{Mum, map[string]string{"Red": "RRyyWW", "Yellow": "rrYYWW", "White": "rryyWw"}},
+	}
+
+	for _, tt := range tests {
+		seeds := tt.species.Seeds()
+		if len(seeds) != len(tt.want) {
+			t.Errorf("%s has %d seeds, want %d", tt.species, len(seeds), len(tt.want))
+		}
+		for _, seed := range seeds {
+			if got := seed.Genotype.String(); got != tt.want[seed.Colour] {
+				t.Errorf("%s %s seed = %s, want %s", tt.species, seed.Colour, got, tt.want[seed.Colour])
+			}
+			if got := seed.Genotype.Colour(); got != seed.Colour {
+				t.Errorf("%s %s seed %s grows %s", tt.species, seed.Colour, seed.Genotype, got)
+			}
+		}
+	}
+}
+
+func TestBreedKnownCrosses(t *testing.T) {
+	tests := []struct {
+		name    string
+		species Species
+		a, b    string
+		colour  string
+		want    float64
+	}{
+		{"white roses", Rose, "rryyWwss", "rryyWwss", "Purple", 0.25},
+		{"red roses", Rose, "RRyyWWSs", "RRyyWWSs", "Black", 0.25},
+		{"red and yellow roses", Rose, "RRyyWWSs", "rrYYWWss", "Orange", 0.5},
+		{"blue roses", Rose, "RRYYwwss", "RRYYwwss", "Blue", 1},
+		{"hybrid red roses", Rose, "RrYyWwss", "RrYyWwss", "Blue", 1.0 / 64},
+		{"red tulips", Tulip, "RRyySs", "RRyySs", "Black", 0.25},
+		{"red and yellow tulips", Tulip, "RRyySs", "rrYYss", "Orange", 0.5},
+		{"white pansies", Pansy, "rryyWw", "rryyWw", "Blue", 0.25},
+		{"red and yellow pansies", Pansy, "RRyyWW", "rrYYWW", "Orange", 1},
+		{"red and yellow cosmos", Cosmos, "RRyyss", "rrYYSs", "Orange", 1},
+		{"red lilies", Lily, "RRyySs", "RRyySs", "Black", 0.25},
+		{"white hyacinths", Hyacinth, "rryyWw", "rryyWw", "Blue", 0.25},
+		{"red and yellow hyacinths", Hyacinth, "RRyyWw", "rrYYWW", "Orange", 0.5},
+		{"white windflowers", Windflower, "rrooWw", "rrooWw", "Blue", 0.25},
+		{"red and orange windflowers", Windflower, "RRooWW", "rrOOWW", "Pink", 1},
+		{"white mums", Mum, "rryyWw", "rryyWw", "Purple", 0.25},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := mustParse(t, tt.species, tt.a)
+			b := mustParse(t, tt.species, tt.b)
+			if got := colourOdds(t, a, b, tt.colour); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("%s x %s gives %s %.4f, want %.4f", tt.a, tt.b, tt.colour, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBreedOutcomesSumToOne(t *testing.T) {
+	for _, s := range AllSpecies {
+		seeds := s.Seeds()
+		for _, a := range seeds {
+			for _, b := range seeds {
+				outcomes, err := Breed(a.Genotype, b.Genotype)
+				if err != nil {
+					t.Fatalf("Breed(%s, %s): %v", a.Genotype, b.Genotype, err)
+				}
+				total := 0.0
+				for _, o := range outcomes {
+					total += o.Probability
+				}
+				if math.Abs(total-1) > 1e-9 {
+					t.Errorf("%s %s x %s outcomes sum to %f", s, a.Genotype, b.Genotype, total)
+				}
+			}
+		}
+	}
+}
+
+func TestBreedSpeciesMismatch(t *testing.T) {
+	_, err := Breed(mustParse(t, Rose, "RRyyWWSs"), mustParse(t, Tulip, "RRyySs"))
+	if !errors.Is(err, ErrSpeciesMismatch) {
+		t.Errorf("err = %v, want ErrSpeciesMismatch", err)
+	}
+}
+
+func TestParseGenotype(t *testing.T) {
+	tests := []struct {
+		text   string
+		want   string
+		digits string
+	}{
+		{"RRYYwwss", "RRYYwwss", "2200"},
+		{"RrYy-Ww-ss", "RrYyWwss", "1110"},
+		{"rR yY wW Ss", "RrYyWwSs", "1111"},
+		{"2021", "RRyyWWSs", "2021"},
+	}
+
+	for _, tt := range tests {
+		g := mustParse(t, Rose, tt.text)
+		if got := g.String(); got != tt.want {
+			t.Errorf("ParseGenotype(%q).String() = %s, want %s", tt.text, got, tt.want)
+		}
+		if got := g.Digits(); got != tt.digits {
+			t.Errorf("ParseGenotype(%q).Digits() = %s, want %s", tt.text, got, tt.digits)
+		}
+		if back := mustParse(t, Rose, g.Digits()); back != g {
+			t.Errorf("%s does not round trip through %s", g, g.Digits())
+		}
+	}
+
+	for _, text := range []string{"", "RRYYwws", "RRYYwwsx", "3000", "RRYYWWSSS"} {
+		if _, err := ParseGenotype(Rose, text); !errors.Is(err, ErrInvalidGenotype) {
+			t.Errorf("ParseGenotype(%q) err = %v, want ErrInvalidGenotype", text, err)
+		}
+	}
+	if _, err := ParseGenotype("daisy", "RR"); !errors.Is(err, ErrUnknownSpecies) {
+		t.Errorf("ParseGenotype(daisy) err = %v, want ErrUnknownSpecies", err)
+	}
+}
+
+func TestBlueRosePlan(t *testing.T) {
+	plan, err := BlueRosePlan()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Steps) == 0 {
+		t.Fatal("plan has no steps")
+	}
+
+	last := plan.Steps[len(plan.Steps)-1]
+	if last.Colour != "Blue" {
+		t.Errorf("last step keeps %s, want Blue", last.Colour)
+	}
+	for _, g := range last.Offspring {
+		if g.String() != "RRYYwwss" {
+			t.Errorf("last step keeps %s, want RRYYwwss", g)
+		}
+	}
+
+	// Every parent has to be a seed or kept from an earlier step
+	have := map[Genotype]bool{}
+	for _, seed := range plan.Seeds {
+		have[seed.Genotype] = true
+	}
+	for i, step := range plan.Steps {
+		for _, parent := range []Genotype{step.ParentA, step.ParentB} {
+			if !have[parent] {
+				t.Errorf("step %d breeds %s before it is available", i+1, parent)
+			}
+		}
+		for _, g := range step.Offspring {
+			have[g] = true
+		}
+	}
+}
+
+func TestPlanColourErrors(t *testing.T) {
+	if _, err := PlanColour(Rose, "green"); !errors.Is(err, ErrUnknownColour) {
+		t.Errorf("PlanColour(rose, green) err = %v, want ErrUnknownColour", err)
+	}
+	if _, err := PlanColour("daisy", "white"); !errors.Is(err, ErrUnknownSpecies) {
+		t.Errorf("PlanColour(daisy) err = %v, want ErrUnknownSpecies", err)
+	}
+}
@@ -0,0 +1,170 @@
+package flowers
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// MaxGenerations bounds how many generations Plan searches
+const MaxGenerations = 8
+
+var (
+	ErrUnknownColour = errors.New("unknown colour")
+	ErrNoPlan        = errors.New("no breeding plan found")
+)
+
+// Step is one cross in a breeding plan: breed ParentA with ParentB and keep
+// the offspring of Colour, which turn up with the given Probability. Every
+// step but the last keeps exactly one genotype, so the colour alone tells
+// which flower to keep; the last step may keep several genotypes of the
+// target colour.
+type Step struct {
+	Generation  int
+	ParentA     Genotype
+	ParentB     Genotype
+	Colour      string
+	Offspring   []Genotype
+	Probability float64
+}
+
+// Plan is a sequence of crosses from seed flowers to a target colour
+type Plan struct {
+	Species Species
+	Colour  string
+	Seeds   []Seed
+	Steps   []Step
+}
+
+// known is a genotype the planner can get hold of and how
+type known struct {
+	generation int
+	step       *Step // nil for seeds
+}
+
+// BlueRosePlan plans the way to a blue rose from seed roses
+func BlueRosePlan() (Plan, error) {
+	return PlanColour(Rose, "Blue")
+}
+
+// PlanColour searches for the fewest generations of crosses that take the
+// species' seed flowers to the target colour. It only relies on offspring
+// that can be told apart by colour, so each step can be followed without
+// test breeding. Within a generation it prefers the likelier cross. The
+// colour matches case-insensitively.
+func PlanColour(s Species, colour string) (Plan, error) {
+	if _, ok := speciesInfo[s]; !ok {
+		return Plan{}, fmt.Errorf("%w: %q", ErrUnknownSpecies, s)
+	}
+
+	i := slices.IndexFunc(s.Colours(), func(c string) bool { return strings.EqualFold(c, colour) })
+	if i < 0 {
+		return Plan{}, fmt.Errorf("%w: %s %s", ErrUnknownColour, colour, s)
+	}
+	colour = s.Colours()[i]
+
+	plan := Plan{Species: s, Colour: colour}
+	pool := map[Genotype]known{}
+	for _, seed := range s.Seeds() {
+		pool[seed.Genotype] = known{}
+		if seed.Colour == colour {
+			plan.Seeds = []Seed{seed}
+			return plan, nil
+		}
+	}
+
+	for generation := 1; generation <= MaxGenerations; generation++ {
+		parents := make([]Genotype, 0, len(pool))
+		for g := range pool {
+			parents = append(parents, g)
+		}
+		sort.Slice(parents, func(i, j int) bool { return parents[i].index() < parents[j].index() })
+
+		var goal *Step
+		found := map[Genotype]*Step{}
+		for i, a := range parents {
+			for _, b := range parents[i:] {
+				// crosses of older flowers were all tried in earlier generations
+				if pool[a].generation < generation-1 && pool[b].generation < generation-1 {
+					continue
+				}
+
+				outcomes, _ := Breed(a, b)
+				byColour := map[string][]Outcome{}
+				for _, o := range outcomes {
+					byColour[o.Colour] = append(byColour[o.Colour], o)
+				}
+
+				for c, group := range byColour {
+					step := &Step{Generation: generation, ParentA: a, ParentB: b, Colour: c}
+					for _, o := range group {
+						step.Offspring = append(step.Offspring, o.Genotype)
+						step.Probability += o.Probability
+					}
+
+					switch {
+					case c == colour:
+						if goal == nil || step.Probability > goal.Probability {
+							goal = step
+						}
+					case len(group) == 1:
+						g := group[0].Genotype
+						if _, ok := pool[g]; ok {
+							continue
+						}
+						if best, ok := found[g]; !ok || step.Probability > best.Probability {
+							found[g] = step
+						}
+					}
+				}
+			}
+		}
+
+		if goal != nil {
+			plan.Seeds, plan.Steps = trace(s, pool, goal)
+			return plan, nil
+		}
+		if len(found) == 0 {
+			break
+		}
+		for g, step := range found {
+			pool[g] = known{generation: generation, step: step}
+		}
+	}
+
+	return Plan{}, fmt.Errorf("%w: %s %s", ErrNoPlan, colour, s)
+}
+
+// trace walks back from the final step to the seeds, returning the seeds
+// used and every step in the order they have to be bred
+func trace(s Species, pool map[Genotype]known, goal *Step) ([]Seed, []Step) {
+	seen := map[Genotype]bool{}
+	var steps []Step
+	var visit func(g Genotype)
+	visit = func(g Genotype) {
+		if seen[g] {
+			return
+		}
+		seen[g] = true
+		if step := pool[g].step; step != nil {
+			visit(step.ParentA)
+			visit(step.ParentB)
+			steps = append(steps, *step)
+		}
+	}
+	visit(goal.ParentA)
+	visit(goal.ParentB)
+	steps = append(steps, *goal)
+
+	var seeds []Seed
+	for _, seed := range s.Seeds() {
+		if seen[seed.Genotype] {
+			seeds = append(seeds, seed)
+		}
+	}
+
+	sort.SliceStable(steps, func(i, j int) bool { return steps[i].Generation < steps[j].Generation })
+	return seeds, steps
+}
@@ -61,4 +61,11 @@ func (f *Form) IsEmail(field string) {
 	if !govalidator.IsEmail(f.Get(field)) {
 		f.Errors.Add(field, "Invalid email address")
 	}
-}
\ No newline at end of file
+}
+
+// Matches checks that two fields hold the same value
+func (f *Form) Matches(field, other string) {
+	if f.Get(field) != f.Get(other) {
+		f.Errors.Add(other, "This field must match")
+	}
+}
@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+)
+
+// listArt returns every art piece with the session user's donations,
+// narrowed to paintings or statues by the optional "type" query parameter.
+// On failure it writes the error response and returns ok == false.
+func (m *Repository) listArt(w http.ResponseWriter, r *http.Request) (art []models.Art, ok bool) {
+	artType := r.URL.Query().Get("type")
+	if artType != "" && artType != "painting" && artType != "statue" {
+		http.Error(w, "invalid type", http.StatusBadRequest)
+		return nil, false
+	}
+
+	items, ok := m.listCollectibles(w, r, models.CategoryArt)
+	if !ok {
+		return nil, false
+	}
+
+	art = make([]models.Art, 0, len(items))
+	for _, item := range items {
+		a := models.ArtFromCollectible(item)
+		if artType == "" || a.Type == artType {
+			art = append(art, a)
+		}
+	}
+
+	return art, true
+}
+
+// GetArt serves the art catalog with forgery tips and the user's donated
+// count
+func (m *Repository) GetArt(w http.ResponseWriter, r *http.Request) {
+	art, ok := m.listArt(w, r)
+	if !ok {
+		return
+	}
+
+	response := struct {
+		Art          []models.Art `json:"art"`
+		DonatedCount int          `json:"donated_count"`
+	}{
+		Art: art,
+	}
+	for _, a := range art {
+		if a.Donated {
+			response.DonatedCount++
+		}
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
+
+// GetMissingArt lists the pieces the user has yet to donate, along with how
+// to spot a forgery of each, for checking Redd's stock
+func (m *Repository) GetMissingArt(w http.ResponseWriter, r *http.Request) {
+	art, ok := m.listArt(w, r)
+	if !ok {
+		return
+	}
+
+	missing := make([]models.Art, 0, len(art))
+	for _, a := range art {
+		if !a.Donated {
+			missing = append(missing, a)
+		}
+	}
+
+	response := struct {
+		Art []models.Art `json:"art"`
+	}{
+		Art: missing,
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
+
+func (m *Repository) UpdateArtDonated(w http.ResponseWriter, r *http.Request) {
+	var payload struct {
+		ArtID   string `json:"art_id"`
+		Donated bool   `json:"donated"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	if payload.ArtID == "" {
+		http.Error(w, "missing art_id", http.StatusBadRequest)
+		return
+	}
+
+	m.setDonated(w, r, models.CategoryArt, payload.ArtID, payload.Donated)
+}
@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/calendar"
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+	"github.com/mcgigglepop/acnh-finder/server/internal/render"
+)
+
+// residentVillagers returns the directory entries of the user's current
+// residents in move-in order, along with the IDs of any residents missing
+// from the directory, whose birthdays aren't known
+func (m *Repository) residentVillagers(r *http.Request, userSub string) (found []models.Villager, missing []string, err error) {
+	user, err := m.App.Stores.UserProfile.GetUserProfile(r.Context(), userSub)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	villagers, err := m.App.Stores.Villager.ListVillagers(r.Context())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	byID := make(map[string]models.Villager, len(villagers))
+	for _, v := range villagers {
+		byID[v.VillagerID] = v
+	}
+
+	for _, id := range user.Residents() {
+		if v, ok := byID[id]; ok {
+			found = append(found, v)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+
+	return found, missing, nil
+}
+
+// calendarYear reads the optional "year" query parameter and returns that
+// year's events for the user's hemisphere and residents, along with the
+// residents whose birthdays aren't known. On failure it writes the error
+// response and returns ok == false.
+func (m *Repository) calendarYear(w http.ResponseWriter, r *http.Request) (year int, hemisphere string, events []calendar.Event, unknown []string, ok bool) {
+	hemisphere = m.App.Session.GetString(r.Context(), "user_hemisphere")
+	if hemisphere == "" {
+		http.Redirect(w, r, "/choose-hemisphere", http.StatusSeeOther)
+		return 0, "", nil, nil, false
+	}
+
+	userSub := m.App.Session.GetString(r.Context(), "user_id")
+	if userSub == "" {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return 0, "", nil, nil, false
+	}
+
+	year = time.Now().Year()
+	if y := r.URL.Query().Get("year"); y != "" {
+		var err error
+		year, err = strconv.Atoi(y)
+		if err != nil || year < 2000 || year > 2100 {
+			http.Error(w, "invalid year", http.StatusBadRequest)
+			return 0, "", nil, nil, false
+		}
+	}
+
+	residents, unknown, err := m.residentVillagers(r, userSub)
+	if err != nil {
+		log.Printf("failed to load residents: %v", err)
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return 0, "", nil, nil, false
+	}
+
+	if len(unknown) > 0 {
+		log.Printf("residents missing from the villager directory for %s: %v", userSub, unknown)
+	}
+
+	return year, hemisphere, calendar.Year(year, hemisphere, residents), unknown, true
+}
+
+// calendarMonth is one month of the calendar page
+type calendarMonth struct {
+	Name   string
+	Events []calendar.Event
+}
+
+func (m *Repository) CalendarGet(w http.ResponseWriter, r *http.Request) {
+	year, hemisphere, events, unknown, ok := m.calendarYear(w, r)
+	if !ok {
+		return
+	}
+
+	months := make([]calendarMonth, 0, 12)
+	for month := time.January; month <= time.December; month++ {
+		months = append(months, calendarMonth{
+			Name:   month.String(),
+			Events: calendar.InMonth(events, month),
+		})
+	}
+
+	render.Template(w, r, "calendar.page.tmpl", &models.TemplateData{
+		StringMap: map[string]string{
+			"nav": "calendar",
+		},
+		IntMap: map[string]int{
+			"year":     year,
+			"prevYear": year - 1,
+			"nextYear": year + 1,
+		},
+		Data: map[string]interface{}{
+			"Hemisphere":       hemisphere,
+			"Months":           months,
+			"UnknownResidents": unknown,
+		},
+	})
+}
+
+// GetCalendarEvents serves the year's events, optionally narrowed to the
+// "month" query parameter (1-12)
+func (m *Repository) GetCalendarEvents(w http.ResponseWriter, r *http.Request) {
+	year, hemisphere, events, unknown, ok := m.calendarYear(w, r)
+	if !ok {
+		return
+	}
+
+	if mo := r.URL.Query().Get("month"); mo != "" {
+		month, err := strconv.Atoi(mo)
+		if err != nil || month < 1 || month > 12 {
+			http.Error(w, "invalid month", http.StatusBadRequest)
+			return
+		}
+		events = calendar.InMonth(events, time.Month(month))
+	}
+
+	if events == nil {
+		events = []calendar.Event{}
+	}
+
+	if unknown == nil {
+		unknown = []string{}
+	}
+
+	response := struct {
+		Year             int              `json:"year"`
+		Hemisphere       string           `json:"hemisphere"`
+		Events           []calendar.Event `json:"events"`
+		UnknownResidents []string         `json:"unknown_residents"` // residents without a known birthday
+	}{
+		Year:             year,
+		Hemisphere:       hemisphere,
+		Events:           events,
+		UnknownResidents: unknown,
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
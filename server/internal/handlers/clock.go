@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+	"github.com/mcgigglepop/acnh-finder/server/internal/repository"
+)
+
+// islandNow returns what the user's island clock reads right now
+func (m *Repository) islandNow(r *http.Request, userSub string) (time.Time, error) {
+	user, err := m.App.Stores.UserProfile.GetUserProfile(r.Context(), userSub)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return user.IslandTime(time.Now())
+}
+
+// clockResponse is the user's island clock settings and current reading
+type clockResponse struct {
+	TimeZone    string    `json:"time_zone"`
+	ClockOffset int       `json:"clock_offset"`
+	IslandTime  time.Time `json:"island_time"`
+}
+
+// GetClock serves the user's time zone, island clock offset in minutes and
+// the time their island clock reads now
+func (m *Repository) GetClock(w http.ResponseWriter, r *http.Request) {
+	userSub := m.App.Session.GetString(r.Context(), "user_id")
+	if userSub == "" {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	user, err := m.App.Stores.UserProfile.GetUserProfile(r.Context(), userSub)
+	if err != nil {
+		log.Printf("failed to get profile: %v", err)
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	islandTime, err := user.IslandTime(time.Now())
+	if err != nil {
+		log.Printf("failed to read island clock: %v", err)
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	json.NewEncoder(w).Encode(clockResponse{
+		TimeZone:    user.TimeZone,
+		ClockOffset: user.ClockOffset,
+		IslandTime:  islandTime,
+	})
+}
+
+// UpdateClockPost sets the user's time zone, an IANA name such as
+// "America/New_York", and the minutes their island clock runs ahead of real
+// time (negative if behind). An empty time zone falls back to the server's.
+func (m *Repository) UpdateClockPost(w http.ResponseWriter, r *http.Request) {
+	var payload struct {
+		TimeZone    string `json:"time_zone"`
+		ClockOffset int    `json:"clock_offset"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	if err := models.ValidateClock(payload.TimeZone, payload.ClockOffset); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	userSub := m.App.Session.GetString(r.Context(), "user_id")
+	if userSub == "" {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	err := m.App.Stores.UserProfile.UpdateUserClock(r.Context(), userSub, payload.TimeZone, payload.ClockOffset)
+	if errors.Is(err, repository.ErrNotFound) {
+		http.Error(w, "profile not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.Printf("failed to update clock: %v", err)
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	m.GetClock(w, r)
+}
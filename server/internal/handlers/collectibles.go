@@ -0,0 +1,501 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/mcgigglepop/acnh-finder/server/internal/availability"
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+	"github.com/mcgigglepop/acnh-finder/server/internal/render"
+	"github.com/mcgigglepop/acnh-finder/server/internal/repository"
+)
+
+// collectiblePage renders a category's filter page for the user's hemisphere
+func (m *Repository) collectiblePage(w http.ResponseWriter, r *http.Request, page, nav string) {
+	userHemisphere := m.App.Session.GetString(r.Context(), "user_hemisphere")
+	if userHemisphere == "" {
+		http.Redirect(w, r, "/choose-hemisphere", http.StatusSeeOther)
+		return
+	}
+
+	render.Template(w, r, page, &models.TemplateData{
+		StringMap: map[string]string{
+			"nav": nav,
+		},
+		Data: map[string]interface{}{
+			"Hemisphere": userHemisphere,
+		},
+	})
+}
+
+func (m *Repository) FishFilterGet(w http.ResponseWriter, r *http.Request) {
+	m.collectiblePage(w, r, "fish-dashboard.page.tmpl", "fish-filter")
+}
+
+func (m *Repository) BugFilterGet(w http.ResponseWriter, r *http.Request) {
+	m.collectiblePage(w, r, "bug-dashboard.page.tmpl", "bugs-filter")
+}
+
+func (m *Repository) SeaCreatureFilterGet(w http.ResponseWriter, r *http.Request) {
+	m.collectiblePage(w, r, "sea-creature-dashboard.page.tmpl", "sea-creatures-filter")
+}
+
+// collectionCounts is how many of a category's entries a user has caught and
+// donated
+type collectionCounts struct {
+	Caught  int
+	Donated int
+}
+
+// countCollection returns the user's caught and donated counts for a category
+func (m *Repository) countCollection(r *http.Request, userID, category string) (collectionCounts, error) {
+	var counts collectionCounts
+	var err error
+
+	counts.Caught, err = m.App.Stores.Collection.CountCaught(r.Context(), userID, category)
+	if err != nil {
+		return counts, err
+	}
+	counts.Donated, err = m.App.Stores.Collection.CountDonated(r.Context(), userID, category)
+	if err != nil {
+		return counts, err
+	}
+
+	return counts, nil
+}
+
+// availableQuery is what listAvailable filtered on
+type availableQuery struct {
+	Hemisphere string
+	Month      int
+	Clock      availability.Clock
+	// Moment is the month and time as a point in time: the island clock in
+	// "now" mode, otherwise today's day of the month this year where the
+	// month has it
+	Moment time.Time
+}
+
+// filterMoment returns the month and clock time this year, on today's day
+// of the month where the month has it
+func filterMoment(month int, clock availability.Clock) time.Time {
+	now := time.Now()
+	last := time.Date(now.Year(), time.Month(month)+1, 0, 0, 0, 0, 0, time.Local).Day()
+	return time.Date(now.Year(), time.Month(month), min(now.Day(), last), 0, int(clock), 0, 0, time.Local)
+}
+
+// listAvailable reads the month and time filters and returns the category's
+// available entries along with how many the user has caught and donated.
+// With "now=1" it ignores the filters and uses the user's island clock. On
+// failure it writes the error response and returns ok == false.
+func (m *Repository) listAvailable(w http.ResponseWriter, r *http.Request, category string) (items []models.Collectible, counts collectionCounts, query availableQuery, ok bool) {
+	query.Hemisphere = m.App.Session.GetString(r.Context(), "user_hemisphere")
+	if query.Hemisphere == "" {
+		http.Redirect(w, r, "/choose-hemisphere", http.StatusSeeOther)
+		return nil, counts, query, false
+	}
+
+	userID := m.App.Session.GetString(r.Context(), "user_id")
+	if userID == "" {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return nil, counts, query, false
+	}
+
+	if now := r.URL.Query().Get("now"); now == "1" || now == "true" {
+		islandTime, err := m.islandNow(r, userID)
+		if err != nil {
+			log.Printf("failed to read island clock: %v", err)
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			return nil, counts, query, false
+		}
+		query.Month = int(islandTime.Month())
+		query.Clock = availability.ClockOf(islandTime)
+		query.Moment = islandTime
+	} else {
+		month, err := strconv.Atoi(r.URL.Query().Get("month"))
+		if err != nil || month < 1 || month > 12 {
+			http.Error(w, "invalid month", http.StatusBadRequest)
+			return nil, counts, query, false
+		}
+		query.Month = month
+		query.Clock, err = availability.ParseClock(r.URL.Query().Get("time"))
+		if err != nil {
+			http.Error(w, "invalid time", http.StatusBadRequest)
+			return nil, counts, query, false
+		}
+		query.Moment = filterMoment(query.Month, query.Clock)
+	}
+
+	items, err := m.App.Stores.Catalog.ListAvailable(r.Context(), category, userID, query.Month, query.Clock, query.Hemisphere)
+	if err != nil {
+		log.Printf("failed to list available %s: %v", category, err)
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return nil, counts, query, false
+	}
+
+	counts, err = m.countCollection(r, userID, category)
+	if err != nil {
+		log.Printf("failed to count %s collection: %v", category, err)
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return nil, counts, query, false
+	}
+
+	return items, counts, query, true
+}
+
+// listCollectibles returns the category's whole catalog with the session
+// user's progress. On failure it writes the error response and returns
+// ok == false.
+func (m *Repository) listCollectibles(w http.ResponseWriter, r *http.Request, category string) (items []models.Collectible, ok bool) {
+	userID := m.App.Session.GetString(r.Context(), "user_id")
+	if userID == "" {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return nil, false
+	}
+
+	items, err := m.App.Stores.Catalog.ListCollectibles(r.Context(), category, userID)
+	if err != nil {
+		log.Printf("failed to list %s: %v", category, err)
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return nil, false
+	}
+
+	return items, true
+}
+
+// GetAvailableCollectibles serves any category's available entries
+func (m *Repository) GetAvailableCollectibles(w http.ResponseWriter, r *http.Request) {
+	category := chi.URLParam(r, "category")
+	if !models.IsCategory(category) {
+		http.Error(w, "unknown category", http.StatusNotFound)
+		return
+	}
+
+	items, counts, _, ok := m.listAvailable(w, r, category)
+	if !ok {
+		return
+	}
+
+	response := struct {
+		Items        []models.Collectible `json:"items"`
+		CaughtCount  int                  `json:"caught_count"`
+		DonatedCount int                  `json:"donated_count"`
+	}{
+		Items:        items,
+		CaughtCount:  counts.Caught,
+		DonatedCount: counts.Donated,
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
+
+// availableFish is a fish with the window it can next be caught in, as
+// seen from the filtered month and time
+type availableFish struct {
+	models.Fish
+	Next *availability.Window
+}
+
+func (m *Repository) GetAvailableFish(w http.ResponseWriter, r *http.Request) {
+	items, counts, query, ok := m.listAvailable(w, r, models.CategoryFish)
+	if !ok {
+		return
+	}
+
+	fish := make([]availableFish, 0, len(items))
+	for _, item := range items {
+		f := availableFish{Fish: models.FishFromCollectible(item)}
+		if next, ok := availability.Next(availability.Seasons(item, query.Hemisphere), query.Moment); ok {
+			f.Next = &next
+		}
+		fish = append(fish, f)
+	}
+
+	// Wrap in a response object so frontend can use both fish + count
+	response := struct {
+		Fish         []availableFish `json:"fish"`
+		CaughtCount  int             `json:"caught_count"`
+		DonatedCount int             `json:"donated_count"`
+		Month        int             `json:"month"`
+		Time         string          `json:"time"`
+	}{
+		Fish:         fish,
+		Month:        query.Month,
+		Time:         query.Clock.String(),
+		CaughtCount:  counts.Caught,
+		DonatedCount: counts.Donated,
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
+
+func (m *Repository) GetAvailableBugs(w http.ResponseWriter, r *http.Request) {
+	items, counts, _, ok := m.listAvailable(w, r, models.CategoryBug)
+	if !ok {
+		return
+	}
+
+	bugs := make([]models.Bug, 0, len(items))
+	for _, item := range items {
+		bugs = append(bugs, models.BugFromCollectible(item))
+	}
+
+	response := struct {
+		Bugs         []models.Bug `json:"bugs"`
+		CaughtCount  int          `json:"caught_count"`
+		DonatedCount int          `json:"donated_count"`
+	}{
+		Bugs:         bugs,
+		CaughtCount:  counts.Caught,
+		DonatedCount: counts.Donated,
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
+
+func (m *Repository) GetAvailableSeaCreatures(w http.ResponseWriter, r *http.Request) {
+	items, counts, _, ok := m.listAvailable(w, r, models.CategorySeaCreature)
+	if !ok {
+		return
+	}
+
+	creatures := make([]models.SeaCreature, 0, len(items))
+	for _, item := range items {
+		creatures = append(creatures, models.SeaCreatureFromCollectible(item))
+	}
+
+	response := struct {
+		SeaCreatures []models.SeaCreature `json:"sea_creatures"`
+		CaughtCount  int                  `json:"caught_count"`
+		DonatedCount int                  `json:"donated_count"`
+	}{
+		SeaCreatures: creatures,
+		CaughtCount:  counts.Caught,
+		DonatedCount: counts.Donated,
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
+
+// inCatalog reports whether the collectible exists. On failure, including an
+// unknown ID, it writes the error response and returns false.
+func (m *Repository) inCatalog(w http.ResponseWriter, r *http.Request, category, id string) bool {
+	_, err := m.App.Stores.Catalog.GetCollectible(r.Context(), category, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		http.Error(w, "unknown collectible", http.StatusNotFound)
+		return false
+	}
+	if err != nil {
+		log.Printf("failed to fetch %s: %v", category, err)
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return false
+	}
+	return true
+}
+
+// setCaught marks or unmarks a collectible as caught for the session's user
+func (m *Repository) setCaught(w http.ResponseWriter, r *http.Request, category, id string, caught bool) {
+	userID := m.App.Session.GetString(r.Context(), "user_id")
+	if userID == "" {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if !m.inCatalog(w, r, category, id) {
+		return
+	}
+
+	var err error
+	if caught {
+		err = m.App.Stores.Collection.PutCaught(r.Context(), userID, category, id)
+	} else {
+		err = m.App.Stores.Collection.DeleteCaught(r.Context(), userID, category, id)
+	}
+
+	if err != nil {
+		log.Printf("Failed to update caught %s: %v", category, err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// UpdateUserCollectible toggles the caught state of any category's entry.
+// Unmarking an entry as caught also withdraws its donation.
+func (m *Repository) UpdateUserCollectible(w http.ResponseWriter, r *http.Request) {
+	category := chi.URLParam(r, "category")
+	if !models.IsCategory(category) {
+		http.Error(w, "unknown category", http.StatusNotFound)
+		return
+	}
+
+	var payload struct {
+		ID     string `json:"id"`
+		Caught bool   `json:"caught"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	if payload.ID == "" {
+		http.Error(w, "missing id", http.StatusBadRequest)
+		return
+	}
+
+	m.setCaught(w, r, category, payload.ID, payload.Caught)
+}
+
+// setDonated marks or unmarks a collectible as donated to the museum for the
+// session's user
+func (m *Repository) setDonated(w http.ResponseWriter, r *http.Request, category, id string, donated bool) {
+	userID := m.App.Session.GetString(r.Context(), "user_id")
+	if userID == "" {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if !models.Donatable(category) {
+		http.Error(w, "the museum does not take "+models.CategoryName(category), http.StatusBadRequest)
+		return
+	}
+
+	if !m.inCatalog(w, r, category, id) {
+		return
+	}
+
+	var err error
+	if donated {
+		err = m.App.Stores.Collection.PutDonated(r.Context(), userID, category, id)
+	} else {
+		err = m.App.Stores.Collection.DeleteDonated(r.Context(), userID, category, id)
+	}
+
+	if err != nil {
+		log.Printf("Failed to update donated %s: %v", category, err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// UpdateUserDonation toggles the museum donation of any category's entry.
+// Donating also marks the entry caught.
+func (m *Repository) UpdateUserDonation(w http.ResponseWriter, r *http.Request) {
+	category := chi.URLParam(r, "category")
+	if !models.IsCategory(category) {
+		http.Error(w, "unknown category", http.StatusNotFound)
+		return
+	}
+
+	var payload struct {
+		ID      string `json:"id"`
+		Donated bool   `json:"donated"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	if payload.ID == "" {
+		http.Error(w, "missing id", http.StatusBadRequest)
+		return
+	}
+
+	m.setDonated(w, r, category, payload.ID, payload.Donated)
+}
+
+func (m *Repository) UpdateUserFish(w http.ResponseWriter, r *http.Request) {
+	var payload struct {
+		FishID string `json:"fish_id"`
+		Caught bool   `json:"caught"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	if payload.FishID == "" {
+		http.Error(w, "missing fish_id", http.StatusBadRequest)
+		return
+	}
+
+	m.setCaught(w, r, models.CategoryFish, payload.FishID, payload.Caught)
+}
+
+func (m *Repository) UpdateUserBug(w http.ResponseWriter, r *http.Request) {
+	var payload struct {
+		BugID  string `json:"bug_id"`
+		Caught bool   `json:"caught"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	if payload.BugID == "" {
+		http.Error(w, "missing bug_id", http.StatusBadRequest)
+		return
+	}
+
+	m.setCaught(w, r, models.CategoryBug, payload.BugID, payload.Caught)
+}
+
+func (m *Repository) UpdateUserSeaCreature(w http.ResponseWriter, r *http.Request) {
+	var payload struct {
+		SeaCreatureID string `json:"sea_creature_id"`
+		Caught        bool   `json:"caught"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	if payload.SeaCreatureID == "" {
+		http.Error(w, "missing sea_creature_id", http.StatusBadRequest)
+		return
+	}
+
+	m.setCaught(w, r, models.CategorySeaCreature, payload.SeaCreatureID, payload.Caught)
+}
+
+// completion returns the user's critterpedia and museum progress for every
+// category. Recipes are left out: the catalog only seeds a sample of them,
+// so a percentage of it would mean nothing.
+func (m *Repository) completion(r *http.Request, userID string) ([]models.Completion, error) {
+	completion := make([]models.Completion, 0, len(models.Categories))
+	for _, category := range models.Categories {
+		if category == models.CategoryRecipe {
+			continue
+		}
+		total, err := m.App.Stores.Catalog.CountCollectibles(r.Context(), category)
+		if err != nil {
+			return nil, err
+		}
+		counts, err := m.countCollection(r, userID, category)
+		if err != nil {
+			return nil, err
+		}
+
+		completion = append(completion, models.Completion{
+			Category: category,
+			Name:     models.CategoryName(category),
+			Total:    total,
+			Caught:   counts.Caught,
+			Donated:  counts.Donated,
+		})
+	}
+
+	return completion, nil
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/flowers"
+)
+
+// GetFlowerSpecies serves each species' genes, colours and seed bags
+func (m *Repository) GetFlowerSpecies(w http.ResponseWriter, r *http.Request) {
+	type species struct {
+		Species flowers.Species `json:"species"`
+		Genes   string          `json:"genes"`
+		Colours []string        `json:"colours"`
+		Seeds   []flowers.Seed  `json:"seeds"`
+	}
+
+	response := struct {
+		Species []species `json:"species"`
+	}{}
+	for _, s := range flowers.AllSpecies {
+		response.Species = append(response.Species, species{
+			Species: s,
+			Genes:   s.Genes(),
+			Colours: s.Colours(),
+			Seeds:   s.Seeds(),
+		})
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
+
+// FlowerBreedPost crosses two parent genotypes, given as allele pairs
+// ("RrYyWWss") or dominant allele counts ("1120"), and returns the
+// offspring's colour odds along with every possible genotype
+func (m *Repository) FlowerBreedPost(w http.ResponseWriter, r *http.Request) {
+	var payload struct {
+		Species string `json:"species"`
+		ParentA string `json:"parent_a"`
+		ParentB string `json:"parent_b"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	species, err := flowers.ParseSpecies(payload.Species)
+	if err != nil {
+		http.Error(w, "unknown species", http.StatusBadRequest)
+		return
+	}
+
+	parentA, err := flowers.ParseGenotype(species, payload.ParentA)
+	if err != nil {
+		http.Error(w, "invalid parent_a", http.StatusBadRequest)
+		return
+	}
+	parentB, err := flowers.ParseGenotype(species, payload.ParentB)
+	if err != nil {
+		http.Error(w, "invalid parent_b", http.StatusBadRequest)
+		return
+	}
+
+	outcomes, err := flowers.Breed(parentA, parentB)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := struct {
+		Colours   []flowers.ColourOdds `json:"colours"`
+		Offspring []flowers.Outcome    `json:"offspring"`
+	}{
+		Colours:   flowers.Colours(outcomes),
+		Offspring: outcomes,
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
+
+// GetFlowerPlan serves a breeding plan from seed flowers to the "colour"
+// query parameter of the "species" one, a blue rose by default
+func (m *Repository) GetFlowerPlan(w http.ResponseWriter, r *http.Request) {
+	speciesName := r.URL.Query().Get("species")
+	if speciesName == "" {
+		speciesName = string(flowers.Rose)
+	}
+	colour := r.URL.Query().Get("colour")
+	if colour == "" {
+		colour = "Blue"
+	}
+
+	species, err := flowers.ParseSpecies(speciesName)
+	if err != nil {
+		http.Error(w, "unknown species", http.StatusBadRequest)
+		return
+	}
+
+	plan, err := flowers.PlanColour(species, colour)
+	switch {
+	case errors.Is(err, flowers.ErrUnknownColour):
+		http.Error(w, "unknown colour", http.StatusBadRequest)
+		return
+	case errors.Is(err, flowers.ErrNoPlan):
+		http.Error(w, "no breeding plan found", http.StatusNotFound)
+		return
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	json.NewEncoder(w).Encode(plan)
+}
@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+)
+
+// listFossils returns the whole fossil catalog with the session user's
+// progress. On failure it writes the error response and returns ok == false.
+func (m *Repository) listFossils(w http.ResponseWriter, r *http.Request) (fossils []models.Fossil, ok bool) {
+	items, ok := m.listCollectibles(w, r, models.CategoryFossil)
+	if !ok {
+		return nil, false
+	}
+
+	fossils = make([]models.Fossil, 0, len(items))
+	for _, item := range items {
+		fossils = append(fossils, models.FossilFromCollectible(item))
+	}
+
+	return fossils, true
+}
+
+// GetFossils serves the fossil catalog along with the user's assessed,
+// donated and duplicate counts
+func (m *Repository) GetFossils(w http.ResponseWriter, r *http.Request) {
+	fossils, ok := m.listFossils(w, r)
+	if !ok {
+		return
+	}
+
+	response := struct {
+		Fossils        []models.Fossil `json:"fossils"`
+		AssessedCount  int             `json:"assessed_count"`
+		DonatedCount   int             `json:"donated_count"`
+		DuplicateCount int             `json:"duplicate_count"`
+	}{
+		Fossils: fossils,
+	}
+	for _, f := range fossils {
+		if f.Assessed {
+			response.AssessedCount++
+		}
+		if f.Donated {
+			response.DonatedCount++
+		}
+		response.DuplicateCount += f.Duplicates
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
+
+// skeletonProgress lists the parts of one skeleton the museum is still missing
+type skeletonProgress struct {
+	Skeleton   string          `json:"skeleton"`
+	TotalParts int             `json:"total_parts"`
+	Missing    []models.Fossil `json:"missing"`
+}
+
+// missingBySkeleton groups the fossils not yet donated by skeleton, in
+// catalog order. Complete skeletons are left out.
+func missingBySkeleton(fossils []models.Fossil) []skeletonProgress {
+	var groups []skeletonProgress
+	index := make(map[string]int)
+
+	for _, f := range fossils {
+		i, ok := index[f.Skeleton]
+		if !ok {
+			i = len(groups)
+			index[f.Skeleton] = i
+			groups = append(groups, skeletonProgress{Skeleton: f.Skeleton})
+		}
+
+		groups[i].TotalParts++
+		if !f.Donated {
+			groups[i].Missing = append(groups[i].Missing, f)
+		}
+	}
+
+	missing := make([]skeletonProgress, 0, len(groups))
+	for _, g := range groups {
+		if len(g.Missing) > 0 {
+			missing = append(missing, g)
+		}
+	}
+
+	return missing
+}
+
+// GetMissingFossils lists the parts still missing from the museum, grouped
+// by skeleton. Parts already assessed but not donated are included with
+// Assessed set, so the user knows they only need to hand them in.
+func (m *Repository) GetMissingFossils(w http.ResponseWriter, r *http.Request) {
+	fossils, ok := m.listFossils(w, r)
+	if !ok {
+		return
+	}
+
+	response := struct {
+		Skeletons []skeletonProgress `json:"skeletons"`
+	}{
+		Skeletons: missingBySkeleton(fossils),
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
+
+func (m *Repository) UpdateFossilAssessed(w http.ResponseWriter, r *http.Request) {
+	var payload struct {
+		FossilID string `json:"fossil_id"`
+		Assessed bool   `json:"assessed"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	if payload.FossilID == "" {
+		http.Error(w, "missing fossil_id", http.StatusBadRequest)
+		return
+	}
+
+	m.setCaught(w, r, models.CategoryFossil, payload.FossilID, payload.Assessed)
+}
+
+func (m *Repository) UpdateFossilDonated(w http.ResponseWriter, r *http.Request) {
+	var payload struct {
+		FossilID string `json:"fossil_id"`
+		Donated  bool   `json:"donated"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	if payload.FossilID == "" {
+		http.Error(w, "missing fossil_id", http.StatusBadRequest)
+		return
+	}
+
+	m.setDonated(w, r, models.CategoryFossil, payload.FossilID, payload.Donated)
+}
+
+// UpdateFossilDuplicates records how many spare copies of a fossil the user
+// is holding. Any spares imply the fossil has been assessed.
+func (m *Repository) UpdateFossilDuplicates(w http.ResponseWriter, r *http.Request) {
+	var payload struct {
+		FossilID   string `json:"fossil_id"`
+		Duplicates int    `json:"duplicates"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	if payload.FossilID == "" {
+		http.Error(w, "missing fossil_id", http.StatusBadRequest)
+		return
+	}
+	if payload.Duplicates < 0 {
+		http.Error(w, "invalid duplicates", http.StatusBadRequest)
+		return
+	}
+
+	userID := m.App.Session.GetString(r.Context(), "user_id")
+	if userID == "" {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if !m.inCatalog(w, r, models.CategoryFossil, payload.FossilID) {
+		return
+	}
+
+	err := m.App.Stores.Collection.SetDuplicates(r.Context(), userID, models.CategoryFossil, payload.FossilID, payload.Duplicates)
+	if err != nil {
+		log.Printf("Failed to update fossil duplicates: %v", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -1,16 +1,22 @@
 package handlers
 
 import (
-	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
+	"net"
 	"net/http"
-	"strconv"
 	"strings"
+	"time"
 
+	"github.com/mcgigglepop/acnh-finder/server/internal/auth"
 	"github.com/mcgigglepop/acnh-finder/server/internal/config"
 	"github.com/mcgigglepop/acnh-finder/server/internal/forms"
+	"github.com/mcgigglepop/acnh-finder/server/internal/helpers"
 	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+	"github.com/mcgigglepop/acnh-finder/server/internal/ratelimit"
 	"github.com/mcgigglepop/acnh-finder/server/internal/render"
+	"github.com/mcgigglepop/acnh-finder/server/internal/repository"
 )
 
 // repository used by the handlers
@@ -19,15 +25,46 @@ var Repo *Repository
 // the repository type
 type Repository struct {
 	App *config.AppConfig
+	// resetLimiter throttles password reset requests and attempts
+	resetLimiter *ratelimit.Limiter
+	// resendLimiter enforces resendCooldown per email and client, since the
+	// session copy of the cooldown is lost by simply dropping the cookie
+	resendLimiter *ratelimit.Limiter
 }
 
 // NewRepo creates a new repository
 func NewRepo(a *config.AppConfig) *Repository {
 	return &Repository{
-		App: a,
+		App:           a,
+		resetLimiter:  ratelimit.New(5, 15*time.Minute),
+		resendLimiter: ratelimit.New(1, resendCooldown),
 	}
 }
 
+// resendCooldown is the minimum time between confirmation code resends
+const resendCooldown = time.Minute
+
+// otpFields are the six single-digit boxes of the OTP form
+var otpFields = []string{"otpFirst", "otpSecond", "otpThird", "otpFourth", "otpFifth", "otpSixth"}
+
+// otpCode joins the six OTP boxes into a single code
+func otpCode(form *forms.Form) string {
+	var code strings.Builder
+	for _, field := range otpFields {
+		code.WriteString(form.Get(field))
+	}
+	return strings.TrimSpace(code.String())
+}
+
+// clientIP returns the request's remote address without the port
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
 // NewHandlers sets the repository for the handlers
 func NewHandlers(r *Repository) {
 	Repo = r
@@ -55,45 +92,90 @@ func (m *Repository) EmailVerificationGet(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	render.Template(w, r, "email-verification.page.tmpl", &models.TemplateData{})
+	render.Template(w, r, "email-verification.page.tmpl", &models.TemplateData{
+		StringMap: map[string]string{
+			"email": email,
+		},
+	})
+}
+
+func (m *Repository) ForgotPasswordGet(w http.ResponseWriter, r *http.Request) {
+	render.Template(w, r, "forgot-password.page.tmpl", &models.TemplateData{})
+}
+
+func (m *Repository) ResetPasswordGet(w http.ResponseWriter, r *http.Request) {
+	email := m.App.Session.GetString(r.Context(), "reset_email")
+
+	if email == "" {
+		http.Redirect(w, r, "/forgot-password", http.StatusSeeOther)
+		return
+	}
+
+	render.Template(w, r, "reset-password.page.tmpl", &models.TemplateData{
+		StringMap: map[string]string{
+			"email": email,
+		},
+	})
 }
 
 func (m *Repository) DashboardGet(w http.ResponseWriter, r *http.Request) {
-	// Get the user_id (Cognito sub) from session
+	// Get the user_id (auth sub) from session
 	userSub := m.App.Session.GetString(r.Context(), "user_id")
 	if userSub == "" {
 		http.Redirect(w, r, "/login", http.StatusSeeOther)
 		return
 	}
 
-	user, err := m.App.Dynamo.UserProfile.GetUserProfile(r.Context(), userSub)
+	user, err := m.App.Stores.UserProfile.GetUserProfile(r.Context(), userSub)
+	if errors.Is(err, repository.ErrNotFound) {
+		// Sessions from before profiles were provisioned at login
+		if err := m.App.Stores.UserProfile.CreateUserProfile(r.Context(), userSub); err != nil {
+			log.Printf("Couldn't create user profile: %v", err)
+		}
+		http.Redirect(w, r, "/choose-hemisphere", http.StatusSeeOther)
+		return
+	}
 	if err != nil {
 		log.Printf("Couldn't fetch user: %v", err)
-		// redirect to error page or some default page
-	} else {
-		if user.Hemisphere == "unset" {
-			http.Redirect(w, r, "/choose-hemisphere", http.StatusSeeOther)
-		}
+		m.App.Session.Put(r.Context(), "error", "Could not load your profile. Please log in again.")
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+
+	if user.Hemisphere == models.HemisphereUnset {
+		http.Redirect(w, r, "/choose-hemisphere", http.StatusSeeOther)
+		return
 	}
 
 	m.App.Session.Put(r.Context(), "user_hemisphere", user.Hemisphere)
 
-	render.Template(w, r, "dashboard.page.tmpl", &models.TemplateData{})
+	completion, err := m.completion(r, userSub)
+	if err != nil {
+		// The dashboard still works without progress, so don't fail the page
+		log.Printf("Couldn't load collection progress: %v", err)
+	}
+
+	render.Template(w, r, "dashboard.page.tmpl", &models.TemplateData{
+		Data: map[string]interface{}{
+			"Completion": completion,
+		},
+	})
 }
 
 func (m *Repository) ChooseHemisphereGet(w http.ResponseWriter, r *http.Request) {
-	// Get the user_id (Cognito sub) from session
+	// Get the user_id (auth sub) from session
 	userSub := m.App.Session.GetString(r.Context(), "user_id")
 	if userSub == "" {
 		http.Redirect(w, r, "/login", http.StatusSeeOther)
 		return
 	}
 
-	user, err := m.App.Dynamo.UserProfile.GetUserProfile(r.Context(), userSub)
+	user, err := m.App.Stores.UserProfile.GetUserProfile(r.Context(), userSub)
 	if err != nil {
 		log.Printf("Couldn't fetch user: %v", err)
 		m.App.Session.Put(r.Context(), "flash", "something went wrong")
 		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
 	}
 
 	render.Template(w, r, "choose-hemisphere.page.tmpl", &models.TemplateData{
@@ -103,72 +185,6 @@ func (m *Repository) ChooseHemisphereGet(w http.ResponseWriter, r *http.Request)
 	})
 }
 
-func (m *Repository) FishFilterGet(w http.ResponseWriter, r *http.Request) {
-	// Get the user_id (Cognito sub) from session
-	userHemisphere := m.App.Session.GetString(r.Context(), "user_hemisphere")
-	if userHemisphere == "" {
-		http.Redirect(w, r, "/choose-hemisphere", http.StatusSeeOther)
-		return
-	}
-
-	render.Template(w, r, "fish-dashboard.page.tmpl", &models.TemplateData{
-		Data: map[string]interface{}{
-			"Hemisphere": userHemisphere,
-		},
-	})
-}
-
-func (m *Repository) GetAvailableFish(w http.ResponseWriter, r *http.Request) {
-	userHemisphere := m.App.Session.GetString(r.Context(), "user_hemisphere")
-	if userHemisphere == "" {
-		http.Redirect(w, r, "/choose-hemisphere", http.StatusSeeOther)
-		return
-	}
-
-	userID := m.App.Session.GetString(r.Context(), "user_id")
-	if userID == "" {
-		http.Error(w, "unauthorized", http.StatusUnauthorized)
-		return
-	}
-
-	monthStr := r.URL.Query().Get("month")
-	timeStr := r.URL.Query().Get("time")
-
-	month, err := strconv.Atoi(monthStr)
-	if err != nil || month < 1 || month > 12 {
-		http.Error(w, "invalid month", http.StatusBadRequest)
-		return
-	}
-
-	// Get available fish based on filters
-	fish, err := m.App.Dynamo.Fish.ListAvailableFish(r.Context(), userID, month, timeStr, userHemisphere)
-	if err != nil {
-		log.Printf("failed to list available fish: %v", err)
-		http.Error(w, "internal server error", http.StatusInternalServerError)
-		return
-	}
-
-	// Count how many fish has caught
-	count, err := m.App.Dynamo.UserFish.CountCaughtFish(r.Context(), userID)
-	if err != nil {
-		log.Printf("failed to count caught fish: %v", err)
-		http.Error(w, "internal server error", http.StatusInternalServerError)
-		return
-	}
-
-	// Wrap in a response object so frontend can use both fish + count
-	response := struct {
-		Fish        []models.Fish `json:"fish"`
-		CaughtCount int           `json:"caught_count"`
-	}{
-		Fish:        fish,
-		CaughtCount: count,
-	}
-
-	json.NewEncoder(w).Encode(response)
-}
-
-
 //////////////////////////////////////////////////////////////
 //////////////////////////////////////////////////////////////
 ///////////////////// POST REQUESTS //////////////////////////
@@ -201,9 +217,9 @@ func (m *Repository) RegisterPost(w http.ResponseWriter, r *http.Request) {
 	email := strings.TrimSpace(r.Form.Get("email"))
 	password := r.Form.Get("password")
 
-	userErr := m.App.CognitoClient.RegisterUser(r.Context(), email, password)
+	userErr := m.App.Auth.RegisterUser(r.Context(), email, password)
 	if userErr != nil {
-		m.App.ErrorLog.Println("Cognito RegisterUser failed:", userErr)
+		m.App.ErrorLog.Println("RegisterUser failed:", userErr)
 		m.App.Session.Put(r.Context(), "error", "Registration failed. Please try again.")
 		http.Redirect(w, r, "/register", http.StatusSeeOther)
 		return
@@ -232,29 +248,27 @@ func (m *Repository) EmailVerificationPost(w http.ResponseWriter, r *http.Reques
 	}
 
 	form := forms.New(r.PostForm)
-	form.Required("otpFirst", "otpSecond", "otpThird", "otpFourth", "otpFifth", "otpSixth")
+	form.Required(otpFields...)
 
 	if !form.Valid() {
 		log.Printf("[DEBUG] Form validation failed: %+v", form.Errors)
 		render.Template(w, r, "email-verification.page.tmpl", &models.TemplateData{
 			Form: form,
+			StringMap: map[string]string{
+				"email": email,
+			},
 		})
 		return
 	}
 
-	otpCode := strings.TrimSpace(
-		r.Form.Get("otpFirst") +
-			r.Form.Get("otpSecond") +
-			r.Form.Get("otpThird") +
-			r.Form.Get("otpFourth") +
-			r.Form.Get("otpFifth") +
-			r.Form.Get("otpSixth"),
-	)
-
-	_, err := m.App.CognitoClient.ConfirmUser(r.Context(), email, otpCode)
+	err := m.App.Auth.ConfirmUser(r.Context(), email, otpCode(form))
 	if err != nil {
-		m.App.ErrorLog.Printf("Cognito ConfirmUser failed: %v", err)
-		m.App.Session.Put(r.Context(), "error", "Email verification failed. Please try again.")
+		m.App.ErrorLog.Printf("ConfirmUser failed: %v", err)
+		if errors.Is(err, auth.ErrTooManyRequests) {
+			m.App.Session.Put(r.Context(), "error", "Too many incorrect codes. Please request a new code.")
+		} else {
+			m.App.Session.Put(r.Context(), "error", "Email verification failed. Please try again.")
+		}
 		http.Redirect(w, r, "/email-verification", http.StatusSeeOther)
 		return
 	}
@@ -290,101 +304,272 @@ func (m *Repository) LoginPost(w http.ResponseWriter, r *http.Request) {
 	email := strings.TrimSpace(r.Form.Get("email"))
 	password := r.Form.Get("password")
 
-	auth_response, userErr := m.App.CognitoClient.Login(r.Context(), email, password)
+	auth_response, userErr := m.App.Auth.Login(r.Context(), email, password)
+	if errors.Is(userErr, auth.ErrUserNotConfirmed) {
+		// Send them back to verification with a fresh code, since the
+		// original may never have arrived
+		m.App.Session.Put(r.Context(), "user_email", email)
+		if _, err := m.resendConfirmationCode(r, email); err != nil {
+			m.App.ErrorLog.Println("ResendConfirmationCode failed:", err)
+		}
+		m.App.Session.Put(r.Context(), "warning", "Please verify your email first. We've sent you a new code.")
+		http.Redirect(w, r, "/email-verification", http.StatusSeeOther)
+		return
+	}
 	if userErr != nil {
-		m.App.ErrorLog.Println("Cognito Login failed:", userErr)
+		m.App.ErrorLog.Println("Login failed:", userErr)
 		m.App.Session.Put(r.Context(), "error", "Login failed. Please try again.")
 		http.Redirect(w, r, "/login", http.StatusSeeOther)
 		return
 	}
 
-	sub, err := m.App.CognitoClient.ExtractSubFromToken(r.Context(), auth_response.IdToken)
-
+	sub, err := m.App.Auth.ExtractSubFromToken(r.Context(), auth_response.IdToken)
 	if err != nil {
-		// handle error
+		m.App.ErrorLog.Println("ID token verification failed:", err)
+		m.App.Session.Put(r.Context(), "error", "Login failed. Please try again.")
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+
+	// First login after confirmation creates the profile; later logins are
+	// a no-op thanks to the conditional put
+	if err := m.App.Stores.UserProfile.CreateUserProfile(r.Context(), sub); err != nil {
+		m.App.ErrorLog.Println("CreateUserProfile failed:", err)
+		m.App.Session.Put(r.Context(), "error", "Login failed. Please try again.")
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
 	}
 
 	m.App.Session.Put(r.Context(), "user_id", sub)
-	m.App.Session.Put(r.Context(), "id_token", auth_response.IdToken)
-	m.App.Session.Put(r.Context(), "access_token", auth_response.AccessToken)
-	m.App.Session.Put(r.Context(), "refresh_token", auth_response.RefreshToken)
+	helpers.PutAuthTokens(r, auth_response)
 
 	m.App.Session.Put(r.Context(), "flash", "login successfully.")
 	http.Redirect(w, r, "/dashboard", http.StatusSeeOther)
 }
 
-func (m *Repository) ChooseHemispherePost(w http.ResponseWriter, r *http.Request) {
-	if err := m.App.Session.RenewToken(r.Context()); err != nil {
-		m.App.ErrorLog.Println("Session token renewal failed:", err)
+func (m *Repository) ResendConfirmationPost(w http.ResponseWriter, r *http.Request) {
+	email := m.App.Session.GetString(r.Context(), "user_email")
+	if email == "" {
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
 	}
 
-	err := r.ParseForm()
-	if err != nil {
+	wait, err := m.resendConfirmationCode(r, email)
+	switch {
+	case wait > 0:
+		m.App.Session.Put(r.Context(), "warning", fmt.Sprintf("Please wait %d seconds before requesting another code.", int(wait.Seconds())+1))
+	case errors.Is(err, auth.ErrTooManyRequests):
+		m.App.Session.Put(r.Context(), "error", "Too many codes requested. Please try again later.")
+	case err != nil:
+		m.App.ErrorLog.Println("ResendConfirmationCode failed:", err)
+		m.App.Session.Put(r.Context(), "error", "Could not send a new code. Please try again.")
+	default:
+		m.App.Session.Put(r.Context(), "flash", "A new code is on its way.")
+	}
+
+	http.Redirect(w, r, "/email-verification", http.StatusSeeOther)
+}
+
+// resendConfirmationCode sends a new confirmation code unless one was sent
+// within resendCooldown, in which case it returns the time left to wait.
+// Requests from another session for the same email or client are refused
+// with auth.ErrTooManyRequests.
+func (m *Repository) resendConfirmationCode(r *http.Request, email string) (time.Duration, error) {
+	nextAllowed := time.Unix(m.App.Session.GetInt64(r.Context(), "resend_available_at"), 0)
+	if wait := time.Until(nextAllowed); wait > 0 {
+		return wait, nil
+	}
+
+	if !m.resendLimiter.Allow("resend:"+email) || !m.resendLimiter.Allow("resend-ip:"+clientIP(r)) {
+		return 0, auth.ErrTooManyRequests
+	}
+
+	if err := m.App.Auth.ResendConfirmationCode(r.Context(), email); err != nil {
+		return 0, err
+	}
+
+	m.App.Session.Put(r.Context(), "resend_available_at", time.Now().Add(resendCooldown).Unix())
+	return 0, nil
+}
+
+func (m *Repository) ForgotPasswordPost(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
 		m.App.ErrorLog.Println("ParseForm error:", err)
 	}
 
 	form := forms.New(r.PostForm)
+	form.Required("email")
+	form.IsEmail("email")
 
 	if !form.Valid() {
-		render.Template(w, r, "choose-hemisphere.page.tmpl", &models.TemplateData{
+		render.Template(w, r, "forgot-password.page.tmpl", &models.TemplateData{
 			Form: form,
 		})
 		return
 	}
 
-	hemisphere := r.Form.Get("hemisphere")
-	if hemisphere != "north" && hemisphere != "south" {
-		http.Error(w, "Invalid hemisphere selected", http.StatusBadRequest)
+	email := strings.ToLower(strings.TrimSpace(r.Form.Get("email")))
+
+	if !m.resetLimiter.Allow("forgot:"+email) || !m.resetLimiter.Allow("forgot-ip:"+clientIP(r)) {
+		m.App.Session.Put(r.Context(), "error", "Too many reset requests. Please wait a few minutes and try again.")
+		http.Redirect(w, r, "/forgot-password", http.StatusSeeOther)
 		return
 	}
 
-	// Get the user_id (Cognito sub) from session
-	userSub := m.App.Session.GetString(r.Context(), "user_id")
-	if userSub == "" {
-		http.Redirect(w, r, "/login", http.StatusSeeOther)
+	err := m.App.Auth.ForgotPassword(r.Context(), email)
+	switch {
+	case errors.Is(err, auth.ErrTooManyRequests):
+		m.App.Session.Put(r.Context(), "error", "Too many reset requests. Please wait a few minutes and try again.")
+		http.Redirect(w, r, "/forgot-password", http.StatusSeeOther)
+		return
+	case errors.Is(err, auth.ErrInvalidCredentials):
+		// Unknown account; answer as if a code was sent so emails can't be probed
+	case err != nil:
+		m.App.ErrorLog.Println("ForgotPassword failed:", err)
+		m.App.Session.Put(r.Context(), "error", "Could not start the password reset. Please try again.")
+		http.Redirect(w, r, "/forgot-password", http.StatusSeeOther)
 		return
 	}
 
-	err = m.App.Dynamo.UserProfile.UpdateUserHemisphere(r.Context(), userSub, hemisphere)
+	m.App.Session.Put(r.Context(), "reset_email", email)
+	m.App.Session.Put(r.Context(), "flash", "If an account exists for that email, a reset code is on its way.")
+	http.Redirect(w, r, "/reset-password", http.StatusSeeOther)
+}
 
-	m.App.Session.Put(r.Context(), "flash", "hemisphere confirmed")
-	http.Redirect(w, r, "/dashboard", http.StatusSeeOther)
+func (m *Repository) ResetPasswordPost(w http.ResponseWriter, r *http.Request) {
+	email := m.App.Session.GetString(r.Context(), "reset_email")
+	if email == "" {
+		http.Redirect(w, r, "/forgot-password", http.StatusSeeOther)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		m.App.ErrorLog.Println("ParseForm error:", err)
+	}
+
+	form := forms.New(r.PostForm)
+	form.Required(otpFields...)
+	form.Required("password", "password_confirm")
+	form.MinLength("password", 8)
+	form.Matches("password", "password_confirm")
+
+	if !form.Valid() {
+		render.Template(w, r, "reset-password.page.tmpl", &models.TemplateData{
+			Form: form,
+			StringMap: map[string]string{
+				"email": email,
+			},
+		})
+		return
+	}
+
+	if !m.resetLimiter.Allow("reset:"+email) || !m.resetLimiter.Allow("reset-ip:"+clientIP(r)) {
+		m.App.Session.Put(r.Context(), "error", "Too many attempts. Please wait a few minutes and try again.")
+		http.Redirect(w, r, "/reset-password", http.StatusSeeOther)
+		return
+	}
+
+	err := m.App.Auth.ConfirmForgotPassword(r.Context(), email, otpCode(form), r.Form.Get("password"))
+	if err != nil {
+		m.App.ErrorLog.Println("ConfirmForgotPassword failed:", err)
+
+		switch {
+		case errors.Is(err, auth.ErrCodeMismatch):
+			m.App.Session.Put(r.Context(), "error", "That code is incorrect. Please check it and try again.")
+		case errors.Is(err, auth.ErrCodeExpired):
+			m.App.Session.Put(r.Context(), "error", "That code has expired. Please request a new one.")
+		case errors.Is(err, auth.ErrInvalidPassword):
+			m.App.Session.Put(r.Context(), "error", "That password does not meet the requirements.")
+		case errors.Is(err, auth.ErrTooManyRequests):
+			m.App.Session.Put(r.Context(), "error", "Too many attempts. Please request a new code or wait a few minutes and try again.")
+		default:
+			m.App.Session.Put(r.Context(), "error", "Password reset failed. Please try again.")
+		}
+
+		http.Redirect(w, r, "/reset-password", http.StatusSeeOther)
+		return
+	}
+
+	m.App.Session.Remove(r.Context(), "reset_email")
+	m.App.Session.Put(r.Context(), "flash", "Password reset. Please log in with your new password.")
+	http.Redirect(w, r, "/login", http.StatusSeeOther)
+}
+
+func (m *Repository) LogoutPost(w http.ResponseWriter, r *http.Request) {
+	if refreshToken := m.App.Session.GetString(r.Context(), "refresh_token"); refreshToken != "" {
+		if err := m.App.Auth.RevokeToken(r.Context(), refreshToken); err != nil {
+			m.App.ErrorLog.Println("RevokeToken failed:", err)
+		}
+	}
+
+	m.endSession(w, r, "You have been logged out.")
 }
 
-func (m *Repository) UpdateUserFish(w http.ResponseWriter, r *http.Request) {
-	userID := m.App.Session.GetString(r.Context(), "user_id")
-	if userID == "" {
-		http.Error(w, "unauthorized", http.StatusUnauthorized)
+func (m *Repository) LogoutEverywherePost(w http.ResponseWriter, r *http.Request) {
+	accessToken := m.App.Session.GetString(r.Context(), "access_token")
+	if err := m.App.Auth.GlobalSignOut(r.Context(), accessToken); err != nil {
+		m.App.ErrorLog.Println("GlobalSignOut failed:", err)
+		m.App.Session.Put(r.Context(), "error", "Could not sign out of other devices. Please try again.")
+		http.Redirect(w, r, "/dashboard", http.StatusSeeOther)
 		return
 	}
 
-	var payload struct {
-		FishID string `json:"fish_id"`
-		Caught bool   `json:"caught"`
+	m.endSession(w, r, "You have been logged out on every device.")
+}
+
+// endSession destroys the session and sends the user to the login page with a flash message
+func (m *Repository) endSession(w http.ResponseWriter, r *http.Request, flash string) {
+	if err := m.App.Session.Destroy(r.Context()); err != nil {
+		m.App.ErrorLog.Println("Session destroy failed:", err)
+	}
+	if err := m.App.Session.RenewToken(r.Context()); err != nil {
+		m.App.ErrorLog.Println("Session token renewal failed:", err)
 	}
 
-	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
-		http.Error(w, "invalid payload", http.StatusBadRequest)
+	m.App.Session.Put(r.Context(), "flash", flash)
+	http.Redirect(w, r, "/login", http.StatusSeeOther)
+}
+
+func (m *Repository) ChooseHemispherePost(w http.ResponseWriter, r *http.Request) {
+	if err := m.App.Session.RenewToken(r.Context()); err != nil {
+		m.App.ErrorLog.Println("Session token renewal failed:", err)
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		m.App.ErrorLog.Println("ParseForm error:", err)
+	}
+
+	form := forms.New(r.PostForm)
+
+	if !form.Valid() {
+		render.Template(w, r, "choose-hemisphere.page.tmpl", &models.TemplateData{
+			Form: form,
+		})
 		return
 	}
 
-	if payload.FishID == "" {
-		http.Error(w, "missing fish_id", http.StatusBadRequest)
+	hemisphere := r.Form.Get("hemisphere")
+	if hemisphere != "north" && hemisphere != "south" {
+		http.Error(w, "Invalid hemisphere selected", http.StatusBadRequest)
 		return
 	}
 
-	var err error
-	if payload.Caught {
-		err = m.App.Dynamo.UserFish.PutCaughtFish(r.Context(), userID, payload.FishID)
-	} else {
-		err = m.App.Dynamo.UserFish.DeleteCaughtFish(r.Context(), userID, payload.FishID)
+	// Get the user_id (auth sub) from session
+	userSub := m.App.Session.GetString(r.Context(), "user_id")
+	if userSub == "" {
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
 	}
 
+	err = m.App.Stores.UserProfile.UpdateUserHemisphere(r.Context(), userSub, hemisphere)
 	if err != nil {
-		log.Printf("Failed to update userfish: %v", err)
-		http.Error(w, "internal error", http.StatusInternalServerError)
+		m.App.ErrorLog.Println("UpdateUserHemisphere failed:", err)
+		m.App.Session.Put(r.Context(), "error", "Could not save your hemisphere. Please try again.")
+		http.Redirect(w, r, "/choose-hemisphere", http.StatusSeeOther)
 		return
 	}
 
-	w.WriteHeader(http.StatusOK)
+	m.App.Session.Put(r.Context(), "flash", "hemisphere confirmed")
+	http.Redirect(w, r, "/dashboard", http.StatusSeeOther)
 }
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/availability"
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+)
+
+// GetFishNext serves when the "id" fish can next be caught. The
+// "hemisphere" query parameter defaults to the user's, and "at", an RFC
+// 3339 time, to what their island clock reads now; the window's days and
+// times are in at's time zone.
+func (m *Repository) GetFishNext(w http.ResponseWriter, r *http.Request) {
+	fishID := r.URL.Query().Get("id")
+	if fishID == "" {
+		http.Error(w, "missing id", http.StatusBadRequest)
+		return
+	}
+
+	hemisphere := r.URL.Query().Get("hemisphere")
+	if hemisphere == "" {
+		hemisphere = m.App.Session.GetString(r.Context(), "user_hemisphere")
+	}
+	if hemisphere != "north" && hemisphere != "south" {
+		http.Error(w, "invalid hemisphere", http.StatusBadRequest)
+		return
+	}
+
+	var at time.Time
+	var err error
+	if v := r.URL.Query().Get("at"); v != "" {
+		at, err = time.Parse(time.RFC3339, v)
+		if err != nil {
+			http.Error(w, "invalid at", http.StatusBadRequest)
+			return
+		}
+	} else {
+		at, err = m.islandNow(r, m.App.Session.GetString(r.Context(), "user_id"))
+		if err != nil {
+			log.Printf("failed to read island clock: %v", err)
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	items, ok := m.listCollectibles(w, r, models.CategoryFish)
+	if !ok {
+		return
+	}
+
+	for _, item := range items {
+		if item.ID != fishID {
+			continue
+		}
+
+		response := struct {
+			Fish       models.Fish          `json:"fish"`
+			Hemisphere string               `json:"hemisphere"`
+			At         time.Time            `json:"at"`
+			Next       *availability.Window `json:"next"`
+		}{
+			Fish:       models.FishFromCollectible(item),
+			Hemisphere: hemisphere,
+			At:         at,
+		}
+		if next, ok := availability.Next(availability.Seasons(item, hemisphere), at); ok {
+			response.Next = &next
+		}
+
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	http.Error(w, "fish not found", http.StatusNotFound)
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+)
+
+// listRecipes returns every recipe with the session user's learned state. On
+// failure it writes the error response and returns ok == false.
+func (m *Repository) listRecipes(w http.ResponseWriter, r *http.Request) (recipes []models.Recipe, ok bool) {
+	items, ok := m.listCollectibles(w, r, models.CategoryRecipe)
+	if !ok {
+		return nil, false
+	}
+
+	recipes = make([]models.Recipe, 0, len(items))
+	for _, item := range items {
+		recipes = append(recipes, models.RecipeFromCollectible(item))
+	}
+
+	return recipes, true
+}
+
+// GetRecipes serves the sample recipe catalog with the user's learned
+// count. There is no total to measure it against until the full catalog is
+// seeded.
+func (m *Repository) GetRecipes(w http.ResponseWriter, r *http.Request) {
+	recipes, ok := m.listRecipes(w, r)
+	if !ok {
+		return
+	}
+
+	response := struct {
+		Recipes      []models.Recipe `json:"recipes"`
+		LearnedCount int             `json:"learned_count"`
+	}{
+		Recipes: recipes,
+	}
+	for _, recipe := range recipes {
+		if recipe.Learned {
+			response.LearnedCount++
+		}
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
+
+func (m *Repository) UpdateRecipeLearned(w http.ResponseWriter, r *http.Request) {
+	var payload struct {
+		RecipeID string `json:"recipe_id"`
+		Learned  bool   `json:"learned"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	if payload.RecipeID == "" {
+		http.Error(w, "missing recipe_id", http.StatusBadRequest)
+		return
+	}
+
+	m.setCaught(w, r, models.CategoryRecipe, payload.RecipeID, payload.Learned)
+}
+
+// shortRecipe is a recipe the inventory can't cover yet
+type shortRecipe struct {
+	Recipe  models.Recipe     `json:"recipe"`
+	Missing []models.Material `json:"missing"`
+}
+
+// CraftablePost answers "what can I craft with these materials". It takes
+// an inventory of material name to quantity and splits the recipes into
+// those it covers and those it doesn't, listing the shortfall for the
+// latter. With learned_only set, only the user's learned recipes are
+// considered.
+func (m *Repository) CraftablePost(w http.ResponseWriter, r *http.Request) {
+	var payload struct {
+		Inventory   map[string]int `json:"inventory"`
+		LearnedOnly bool           `json:"learned_only"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	for _, quantity := range payload.Inventory {
+		if quantity < 0 {
+			http.Error(w, "invalid inventory", http.StatusBadRequest)
+			return
+		}
+	}
+
+	recipes, ok := m.listRecipes(w, r)
+	if !ok {
+		return
+	}
+
+	response := struct {
+		Craftable []models.Recipe `json:"craftable"`
+		Missing   []shortRecipe   `json:"missing"`
+	}{
+		Craftable: []models.Recipe{},
+		Missing:   []shortRecipe{},
+	}
+	for _, recipe := range recipes {
+		if payload.LearnedOnly && !recipe.Learned {
+			continue
+		}
+
+		if missing := recipe.Missing(payload.Inventory); len(missing) > 0 {
+			response.Missing = append(response.Missing, shortRecipe{Recipe: recipe, Missing: missing})
+		} else {
+			response.Craftable = append(response.Craftable, recipe)
+		}
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log"
+	"math/rand/v2"
+	"net/http"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+)
+
+// listSongs returns every K.K. song with the session user's owned state. On
+// failure it writes the error response and returns ok == false.
+func (m *Repository) listSongs(w http.ResponseWriter, r *http.Request) (songs []models.Song, ok bool) {
+	items, ok := m.listCollectibles(w, r, models.CategorySong)
+	if !ok {
+		return nil, false
+	}
+
+	songs = make([]models.Song, 0, len(items))
+	for _, item := range items {
+		songs = append(songs, models.SongFromCollectible(item))
+	}
+
+	return songs, true
+}
+
+// GetSongs serves the song catalog with the user's owned count
+func (m *Repository) GetSongs(w http.ResponseWriter, r *http.Request) {
+	songs, ok := m.listSongs(w, r)
+	if !ok {
+		return
+	}
+
+	userID := m.App.Session.GetString(r.Context(), "user_id")
+	owned, err := m.App.Stores.Collection.CountCaught(r.Context(), userID, models.CategorySong)
+	if err != nil {
+		log.Printf("failed to count owned songs: %v", err)
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	response := struct {
+		Songs      []models.Song `json:"songs"`
+		OwnedCount int           `json:"owned_count"`
+	}{
+		Songs:      songs,
+		OwnedCount: owned,
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
+
+func (m *Repository) UpdateSongOwned(w http.ResponseWriter, r *http.Request) {
+	var payload struct {
+		SongID string `json:"song_id"`
+		Owned  bool   `json:"owned"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	if payload.SongID == "" {
+		http.Error(w, "missing song_id", http.StatusBadRequest)
+		return
+	}
+
+	m.setCaught(w, r, models.CategorySong, payload.SongID, payload.Owned)
+}
+
+// GetSongRequest suggests what to ask K.K. for on a Saturday night. Secret
+// songs come first since requesting them is the only way to get them;
+// once those are owned it picks one of the remaining unowned songs at
+// random. The suggestion is null when the user owns every song.
+func (m *Repository) GetSongRequest(w http.ResponseWriter, r *http.Request) {
+	songs, ok := m.listSongs(w, r)
+	if !ok {
+		return
+	}
+
+	var secret, unowned []models.Song
+	for _, song := range songs {
+		if song.Owned {
+			continue
+		}
+		if song.Secret {
+			secret = append(secret, song)
+		} else {
+			unowned = append(unowned, song)
+		}
+	}
+
+	response := struct {
+		Suggestion   *models.Song  `json:"suggestion"`
+		Secret       []models.Song `json:"secret"`
+		UnownedCount int           `json:"unowned_count"`
+	}{
+		Secret:       []models.Song{},
+		UnownedCount: len(secret) + len(unowned),
+	}
+	switch {
+	case len(secret) > 0:
+		response.Secret = secret
+		response.Suggestion = &secret[0]
+	case len(unowned) > 0:
+		response.Suggestion = &unowned[rand.IntN(len(unowned))]
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
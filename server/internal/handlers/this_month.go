@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/availability"
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+	"github.com/mcgigglepop/acnh-finder/server/internal/render"
+)
+
+// monthChanges is the uncaught fish that turn over in a month: those last
+// seen this month and those first seen this month
+type monthChanges struct {
+	Month    int           `json:"month"`
+	Leaving  []models.Fish `json:"leaving"`
+	Arriving []models.Fish `json:"arriving"`
+}
+
+// fishMonthChanges reads the optional "month" query parameter, the month on
+// the user's island clock by default, and returns the user's uncaught fish
+// leaving after it or new in it in their hemisphere. On failure it writes
+// the error response and returns ok == false.
+func (m *Repository) fishMonthChanges(w http.ResponseWriter, r *http.Request) (changes monthChanges, ok bool) {
+	userHemisphere := m.App.Session.GetString(r.Context(), "user_hemisphere")
+	if userHemisphere == "" {
+		http.Redirect(w, r, "/choose-hemisphere", http.StatusSeeOther)
+		return changes, false
+	}
+
+	if v := r.URL.Query().Get("month"); v != "" {
+		month, err := strconv.Atoi(v)
+		if err != nil || month < 1 || month > 12 {
+			http.Error(w, "invalid month", http.StatusBadRequest)
+			return changes, false
+		}
+		changes.Month = month
+	} else {
+		islandTime, err := m.islandNow(r, m.App.Session.GetString(r.Context(), "user_id"))
+		if err != nil {
+			log.Printf("failed to read island clock: %v", err)
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			return changes, false
+		}
+		changes.Month = int(islandTime.Month())
+	}
+
+	items, ok := m.listCollectibles(w, r, models.CategoryFish)
+	if !ok {
+		return changes, false
+	}
+
+	changes.Leaving = []models.Fish{}
+	changes.Arriving = []models.Fish{}
+	for _, item := range items {
+		if item.Caught {
+			continue
+		}
+
+		seasons := availability.Seasons(item, userHemisphere)
+		if availability.Leaving(seasons, changes.Month) {
+			changes.Leaving = append(changes.Leaving, models.FishFromCollectible(item))
+		}
+		if availability.Arriving(seasons, changes.Month) {
+			changes.Arriving = append(changes.Arriving, models.FishFromCollectible(item))
+		}
+	}
+
+	return changes, true
+}
+
+// GetFishThisMonth serves the uncaught fish leaving after the month and
+// those new in it
+func (m *Repository) GetFishThisMonth(w http.ResponseWriter, r *http.Request) {
+	changes, ok := m.fishMonthChanges(w, r)
+	if !ok {
+		return
+	}
+
+	json.NewEncoder(w).Encode(changes)
+}
+
+func (m *Repository) FishThisMonthGet(w http.ResponseWriter, r *http.Request) {
+	changes, ok := m.fishMonthChanges(w, r)
+	if !ok {
+		return
+	}
+
+	render.Template(w, r, "fish-this-month.page.tmpl", &models.TemplateData{
+		StringMap: map[string]string{
+			"nav":       "fish-this-month",
+			"month":     time.Month(changes.Month).String(),
+			"nextMonth": time.Month(availability.NextMonth(changes.Month)).String(),
+		},
+		IntMap: map[string]int{
+			"month":     changes.Month,
+			"prevMonth": availability.PrevMonth(changes.Month),
+			"nextMonth": availability.NextMonth(changes.Month),
+		},
+		Data: map[string]interface{}{
+			"Hemisphere": m.App.Session.GetString(r.Context(), "user_hemisphere"),
+			"Leaving":    changes.Leaving,
+			"Arriving":   changes.Arriving,
+		},
+	})
+}
@@ -0,0 +1,194 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+	"github.com/mcgigglepop/acnh-finder/server/internal/repository"
+)
+
+// GetVillagers serves the villager directory, optionally narrowed by the
+// "species" and "personality" query parameters
+func (m *Repository) GetVillagers(w http.ResponseWriter, r *http.Request) {
+	species := r.URL.Query().Get("species")
+	personality := r.URL.Query().Get("personality")
+
+	villagers, err := m.App.Stores.Villager.ListVillagers(r.Context())
+	if err != nil {
+		log.Printf("failed to list villagers: %v", err)
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	matches := make([]models.Villager, 0, len(villagers))
+	for _, v := range villagers {
+		if species != "" && !strings.EqualFold(v.Species, species) {
+			continue
+		}
+		if personality != "" && !strings.EqualFold(v.Personality, personality) {
+			continue
+		}
+		matches = append(matches, v)
+	}
+
+	response := struct {
+		Villagers []models.Villager `json:"villagers"`
+	}{
+		Villagers: matches,
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
+
+// residency is a stay on the island with the villager's directory entry
+type residency struct {
+	Villager   models.Villager `json:"villager"`
+	MovedInAt  time.Time       `json:"moved_in_at"`
+	MovedOutAt *time.Time      `json:"moved_out_at"` // nil while still a resident
+}
+
+// GetIsland serves the user's current residents, their move-in/move-out
+// history and every villager they've hosted
+func (m *Repository) GetIsland(w http.ResponseWriter, r *http.Request) {
+	userSub := m.App.Session.GetString(r.Context(), "user_id")
+	if userSub == "" {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	user, err := m.App.Stores.UserProfile.GetUserProfile(r.Context(), userSub)
+	if err != nil {
+		log.Printf("failed to fetch user: %v", err)
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	villagers, err := m.App.Stores.Villager.ListVillagers(r.Context())
+	if err != nil {
+		log.Printf("failed to list villagers: %v", err)
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	byID := make(map[string]models.Villager, len(villagers))
+	for _, v := range villagers {
+		byID[v.VillagerID] = v
+	}
+	// Villagers missing from the directory are listed by ID alone
+	villager := func(id string) models.Villager {
+		if v, ok := byID[id]; ok {
+			return v
+		}
+		return models.Villager{VillagerID: id}
+	}
+	lookup := func(ids []string) []models.Villager {
+		found := make([]models.Villager, 0, len(ids))
+		for _, id := range ids {
+			found = append(found, villager(id))
+		}
+		return found
+	}
+
+	history := make([]residency, 0, len(user.ResidentHistory))
+	for _, stay := range user.ResidentHistory {
+		entry := residency{
+			Villager:  villager(stay.VillagerID),
+			MovedInAt: stay.MovedInAt,
+		}
+		if !stay.Current() {
+			movedOut := stay.MovedOutAt
+			entry.MovedOutAt = &movedOut
+		}
+		history = append(history, entry)
+	}
+
+	response := struct {
+		Residents    []models.Villager `json:"residents"`
+		MaxResidents int               `json:"max_residents"`
+		History      []residency       `json:"history"`
+		Hosted       []models.Villager `json:"hosted"`
+	}{
+		Residents:    lookup(user.Residents()),
+		MaxResidents: models.MaxResidents,
+		History:      history,
+		Hosted:       lookup(user.Hosted()),
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
+
+// moveVillager reads a villager_id payload and applies the roster move for
+// the session's user
+func (m *Repository) moveVillager(w http.ResponseWriter, r *http.Request, moveIn bool) {
+	userSub := m.App.Session.GetString(r.Context(), "user_id")
+	if userSub == "" {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var payload struct {
+		VillagerID string `json:"villager_id"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	if payload.VillagerID == "" {
+		http.Error(w, "missing villager_id", http.StatusBadRequest)
+		return
+	}
+
+	// Only move-ins are checked against the directory, so a resident who has
+	// since left it can still move out
+	if moveIn {
+		_, err := m.App.Stores.Villager.GetVillager(r.Context(), payload.VillagerID)
+		if errors.Is(err, repository.ErrNotFound) {
+			http.Error(w, "unknown villager", http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("failed to fetch villager: %v", err)
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	var err error
+	if moveIn {
+		err = m.App.Stores.UserProfile.MoveInVillager(r.Context(), userSub, payload.VillagerID)
+	} else {
+		err = m.App.Stores.UserProfile.MoveOutVillager(r.Context(), userSub, payload.VillagerID)
+	}
+
+	switch {
+	case errors.Is(err, models.ErrRosterFull),
+		errors.Is(err, models.ErrAlreadyResident),
+		errors.Is(err, models.ErrNotResident):
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	case errors.Is(err, repository.ErrConflict):
+		http.Error(w, "your island changed, please try again", http.StatusConflict)
+		return
+	case err != nil:
+		log.Printf("Failed to update residents: %v", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (m *Repository) MoveInVillager(w http.ResponseWriter, r *http.Request) {
+	m.moveVillager(w, r, true)
+}
+
+func (m *Repository) MoveOutVillager(w http.ResponseWriter, r *http.Request) {
+	m.moveVillager(w, r, false)
+}
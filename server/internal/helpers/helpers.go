@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"runtime/debug"
 
+	"github.com/mcgigglepop/acnh-finder/server/internal/auth"
 	"github.com/mcgigglepop/acnh-finder/server/internal/config"
 )
 
@@ -33,3 +34,14 @@ func IsAuthenticated(r *http.Request) bool {
 	exists := app.Session.Exists(r.Context(), "user_id")
 	return exists
 }
+
+// PutAuthTokens stores the provider's tokens and their expiry in the session.
+// An empty refresh token keeps the one already stored.
+func PutAuthTokens(r *http.Request, tokens *auth.AuthResponse) {
+	app.Session.Put(r.Context(), "id_token", tokens.IdToken)
+	app.Session.Put(r.Context(), "access_token", tokens.AccessToken)
+	app.Session.Put(r.Context(), "token_expiry", tokens.ExpiresAt.Unix())
+	if tokens.RefreshToken != "" {
+		app.Session.Put(r.Context(), "refresh_token", tokens.RefreshToken)
+	}
+}
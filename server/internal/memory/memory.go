@@ -0,0 +1,405 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/availability"
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+	"github.com/mcgigglepop/acnh-finder/server/internal/repository"
+)
+
+// Store keeps profiles, the catalogs and catch records in memory
+type Store struct {
+	mu       sync.RWMutex
+	profiles map[string]models.User
+	// catalog holds each category's entries in seed order
+	catalog   map[string][]models.Collectible
+	villagers []models.Villager
+	// progress is keyed by user ID, then by collectionKey
+	progress map[string]map[string]models.UserCollectible
+	creds    map[string]models.Credential
+	revoked  map[string]time.Time
+}
+
+var (
+	_ repository.UserProfileStore = (*Store)(nil)
+	_ repository.CatalogStore     = (*Store)(nil)
+	_ repository.CollectionStore  = (*Store)(nil)
+	_ repository.VillagerStore    = (*Store)(nil)
+	_ repository.CredentialStore  = (*Store)(nil)
+)
+
+// New creates an in-memory store loaded with the given catalog and villager
+// directory
+func New(catalog []models.Collectible, villagers []models.Villager) *Store {
+	s := &Store{
+		profiles:  make(map[string]models.User),
+		catalog:   make(map[string][]models.Collectible),
+		villagers: villagers,
+		progress:  make(map[string]map[string]models.UserCollectible),
+		creds:     make(map[string]models.Credential),
+		revoked:   make(map[string]time.Time),
+	}
+	for _, c := range catalog {
+		s.catalog[c.Category] = append(s.catalog[c.Category], c)
+	}
+	return s
+}
+
+// collectionKey identifies a collectible across categories
+func collectionKey(category, id string) string {
+	return category + "#" + id
+}
+
+func (s *Store) CreateUserProfile(ctx context.Context, userSub string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.profiles[userSub]; ok {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	s.profiles[userSub] = models.User{
+		UserID:     userSub,
+		Hemisphere: models.HemisphereUnset,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	return nil
+}
+
+func (s *Store) GetUserProfile(ctx context.Context, userSub string) (*models.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	user, ok := s.profiles[userSub]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	// Don't share the history with callers that might append to it
+	user.ResidentHistory = slices.Clone(user.ResidentHistory)
+
+	return &user, nil
+}
+
+func (s *Store) UpdateUserHemisphere(ctx context.Context, userSub string, hemisphere string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, ok := s.profiles[userSub]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	user.Hemisphere = hemisphere
+	user.UpdatedAt = time.Now().UTC()
+	s.profiles[userSub] = user
+
+	return nil
+}
+
+func (s *Store) UpdateUserClock(ctx context.Context, userSub string, timeZone string, clockOffset int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, ok := s.profiles[userSub]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	user.TimeZone = timeZone
+	user.ClockOffset = clockOffset
+	user.UpdatedAt = time.Now().UTC()
+	s.profiles[userSub] = user
+
+	return nil
+}
+
+func (s *Store) MoveInVillager(ctx context.Context, userSub, villagerID string) error {
+	return s.updateResidents(userSub, func(u *models.User, now time.Time) error {
+		return u.MoveIn(villagerID, now)
+	})
+}
+
+func (s *Store) MoveOutVillager(ctx context.Context, userSub, villagerID string) error {
+	return s.updateResidents(userSub, func(u *models.User, now time.Time) error {
+		return u.MoveOut(villagerID, now)
+	})
+}
+
+// updateResidents applies a roster move to the profile, keeping it unchanged
+// if the move fails
+func (s *Store) updateResidents(userSub string, move func(u *models.User, now time.Time) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, ok := s.profiles[userSub]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	user.ResidentHistory = slices.Clone(user.ResidentHistory)
+
+	now := time.Now().UTC()
+	if err := move(&user, now); err != nil {
+		return err
+	}
+	user.UpdatedAt = now
+	s.profiles[userSub] = user
+
+	return nil
+}
+
+func (s *Store) ListVillagers(ctx context.Context) ([]models.Villager, error) {
+	return slices.Clone(s.villagers), nil
+}
+
+func (s *Store) GetVillager(ctx context.Context, villagerID string) (*models.Villager, error) {
+	for _, v := range s.villagers {
+		if v.VillagerID == villagerID {
+			return &v, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (s *Store) ListAvailable(ctx context.Context, category, userID string, month int, at availability.Clock, hemisphere string) ([]models.Collectible, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	userProgress := s.progress[userID]
+
+	var available []models.Collectible
+	for _, c := range s.catalog[category] {
+		ok, err := availability.Available(availability.Seasons(c, hemisphere), month, at)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check availability of %s %s: %w", category, c.ID, err)
+		}
+		if ok {
+			available = append(available, c.WithProgress(userProgress[collectionKey(category, c.ID)]))
+		}
+	}
+
+	return available, nil
+}
+
+func (s *Store) ListCollectibles(ctx context.Context, category, userID string) ([]models.Collectible, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	userProgress := s.progress[userID]
+
+	all := make([]models.Collectible, 0, len(s.catalog[category]))
+	for _, c := range s.catalog[category] {
+		all = append(all, c.WithProgress(userProgress[collectionKey(category, c.ID)]))
+	}
+
+	return all, nil
+}
+
+func (s *Store) CountCollectibles(ctx context.Context, category string) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.catalog[category]), nil
+}
+
+func (s *Store) GetCollectible(ctx context.Context, category, id string) (*models.Collectible, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, c := range s.catalog[category] {
+		if c.ID == id {
+			return &c, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+// update applies fn to the user's progress on a collectible, creating the
+// record if needed. The caller must hold the write lock.
+func (s *Store) update(userID, category, id string, fn func(p *models.UserCollectible)) {
+	if s.progress[userID] == nil {
+		s.progress[userID] = make(map[string]models.UserCollectible)
+	}
+
+	key := collectionKey(category, id)
+	p, ok := s.progress[userID][key]
+	if !ok {
+		p = models.UserCollectible{UserID: userID, Category: category, ID: id}
+	}
+	fn(&p)
+	s.progress[userID][key] = p
+}
+
+func (s *Store) PutCaught(ctx context.Context, userID, category, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UTC()
+	s.update(userID, category, id, func(p *models.UserCollectible) {
+		if !p.Caught {
+			p.Caught = true
+			p.CaughtAt = now
+		}
+	})
+
+	return nil
+}
+
+func (s *Store) DeleteCaught(ctx context.Context, userID, category, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.progress[userID], collectionKey(category, id))
+
+	return nil
+}
+
+func (s *Store) PutDonated(ctx context.Context, userID, category, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UTC()
+	s.update(userID, category, id, func(p *models.UserCollectible) {
+		if !p.Caught {
+			p.Caught = true
+			p.CaughtAt = now
+		}
+		if !p.Donated {
+			p.Donated = true
+			p.DonatedAt = now
+		}
+	})
+
+	return nil
+}
+
+func (s *Store) DeleteDonated(ctx context.Context, userID, category, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := collectionKey(category, id)
+	if p, ok := s.progress[userID][key]; ok {
+		p.Donated = false
+		p.DonatedAt = time.Time{}
+		s.progress[userID][key] = p
+	}
+
+	return nil
+}
+
+func (s *Store) SetDuplicates(ctx context.Context, userID, category, id string, count int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if count <= 0 {
+		key := collectionKey(category, id)
+		if p, ok := s.progress[userID][key]; ok {
+			p.Duplicates = 0
+			s.progress[userID][key] = p
+		}
+		return nil
+	}
+
+	now := time.Now().UTC()
+	s.update(userID, category, id, func(p *models.UserCollectible) {
+		if !p.Caught {
+			p.Caught = true
+			p.CaughtAt = now
+		}
+		p.Duplicates = count
+	})
+
+	return nil
+}
+
+func (s *Store) CountCaught(ctx context.Context, userID, category string) (int, error) {
+	return s.count(userID, category, func(p models.UserCollectible) bool { return p.Caught }), nil
+}
+
+func (s *Store) CountDonated(ctx context.Context, userID, category string) (int, error) {
+	return s.count(userID, category, func(p models.UserCollectible) bool { return p.Donated }), nil
+}
+
+func (s *Store) CountDuplicates(ctx context.Context, userID, category string) (int, error) {
+	return s.sum(userID, category, func(p models.UserCollectible) int { return p.Duplicates }), nil
+}
+
+// count counts the user's records in the category that match
+func (s *Store) count(userID, category string, match func(p models.UserCollectible) bool) int {
+	return s.sum(userID, category, func(p models.UserCollectible) int {
+		if match(p) {
+			return 1
+		}
+		return 0
+	})
+}
+
+// sum adds up value over the user's records in the category
+func (s *Store) sum(userID, category string, value func(p models.UserCollectible) int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	prefix := collectionKey(category, "")
+	total := 0
+	for key, p := range s.progress[userID] {
+		if strings.HasPrefix(key, prefix) {
+			total += value(p)
+		}
+	}
+
+	return total
+}
+
+// GetCredential looks up a local account by email
+func (s *Store) GetCredential(ctx context.Context, email string) (*models.Credential, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	cred, ok := s.creds[email]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+
+	return &cred, nil
+}
+
+// PutCredential creates or replaces a local account
+func (s *Store) PutCredential(ctx context.Context, cred models.Credential) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.creds[cred.Email] = cred
+
+	return nil
+}
+
+// RevokeToken records a token ID as revoked until the token would have expired
+func (s *Store) RevokeToken(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for id, exp := range s.revoked {
+		if exp.Before(now) {
+			delete(s.revoked, id)
+		}
+	}
+	s.revoked[tokenID] = expiresAt
+
+	return nil
+}
+
+// IsTokenRevoked reports whether the token ID has been revoked
+func (s *Store) IsTokenRevoked(ctx context.Context, tokenID string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.revoked[tokenID]
+	return ok, nil
+}
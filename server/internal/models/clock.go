@@ -0,0 +1,50 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+// MaxClockOffset bounds how far, in minutes, an island clock can be set
+// from real time. The game only allows dates from 2000 to 2060, so no
+// island clock is more than 60 years out.
+const MaxClockOffset = 60 * 366 * 24 * 60
+
+var (
+	ErrInvalidTimeZone    = errors.New("invalid time zone")
+	ErrInvalidClockOffset = errors.New("invalid clock offset")
+)
+
+// ValidateClock checks island clock settings before they are stored
+func ValidateClock(timeZone string, clockOffset int) error {
+	if _, err := time.LoadLocation(timeZone); err != nil {
+		return ErrInvalidTimeZone
+	}
+	if clockOffset < -MaxClockOffset || clockOffset > MaxClockOffset {
+		return ErrInvalidClockOffset
+	}
+	return nil
+}
+
+// Location returns the user's time zone, or the server's when they haven't
+// set one
+func (u User) Location() (*time.Location, error) {
+	if u.TimeZone == "" {
+		return time.Local, nil
+	}
+	loc, err := time.LoadLocation(u.TimeZone)
+	if err != nil {
+		return nil, ErrInvalidTimeZone
+	}
+	return loc, nil
+}
+
+// IslandTime returns what the user's island clock reads at now: now in
+// their time zone, moved by their clock offset for those who time travel
+func (u User) IslandTime(now time.Time) (time.Time, error) {
+	loc, err := u.Location()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return now.In(loc).Add(time.Duration(u.ClockOffset) * time.Minute), nil
+}
@@ -0,0 +1,71 @@
+package models
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestValidateClock(t *testing.T) {
+	tests := []struct {
+		timeZone    string
+		clockOffset int
+		want        error
+	}{
+		{"Europe/London", 0, nil},
+		{"", 0, nil},
+		{"UTC", -90, nil},
+		{"Asia/Tokyo", MaxClockOffset, nil},
+		{"Asia/Tokyo", -MaxClockOffset, nil},
+		{"Asia/Tokyo", MaxClockOffset + 1, ErrInvalidClockOffset},
+		{"Asia/Tokyo", -MaxClockOffset - 1, ErrInvalidClockOffset},
+		{"Mars/Olympus_Mons", 0, ErrInvalidTimeZone},
+	}
+
+	for _, tt := range tests {
+		if err := ValidateClock(tt.timeZone, tt.clockOffset); !errors.Is(err, tt.want) {
+			t.Errorf("ValidateClock(%q, %d) = %v, want %v", tt.timeZone, tt.clockOffset, err, tt.want)
+		}
+	}
+}
+
+func TestIslandTime(t *testing.T) {
+	// Fix the server's zone so the fallback is predictable
+	local := time.Local
+	time.Local = time.FixedZone("server", -5*60*60)
+	defer func() { time.Local = local }()
+
+	now := time.Date(2026, time.January, 1, 0, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		timeZone    string
+		clockOffset int
+		want        string
+	}{
+		{"time zone", "Asia/Tokyo", 0, "2026-01-01 09:30 JST"},
+		{"server zone when unset", "", 0, "2025-12-31 19:30 server"},
+		{"forward offset", "UTC", 90, "2026-01-01 02:00 UTC"},
+		{"negative offset", "UTC", -45, "2025-12-31 23:45 UTC"},
+		{"negative offset across a year", "Asia/Tokyo", -365 * 24 * 60, "2025-01-01 09:30 JST"},
+		{"negative offset in server zone", "", -30, "2025-12-31 19:00 server"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := User{TimeZone: tt.timeZone, ClockOffset: tt.clockOffset}
+			got, err := u.IslandTime(now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s := got.Format("2006-01-02 15:04 MST"); s != tt.want {
+				t.Errorf("IslandTime = %s, want %s", s, tt.want)
+			}
+		})
+	}
+
+	u := User{TimeZone: "Mars/Olympus_Mons"}
+	if _, err := u.IslandTime(now); !errors.Is(err, ErrInvalidTimeZone) {
+		t.Errorf("IslandTime with a bad zone err = %v, want ErrInvalidTimeZone", err)
+	}
+}
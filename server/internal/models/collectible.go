@@ -0,0 +1,371 @@
+package models
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// Collectible categories
+const (
+	CategoryFish        = "fish"
+	CategoryBug         = "bug"
+	CategorySeaCreature = "sea_creature"
+	CategoryFossil      = "fossil"
+	CategoryArt         = "art"
+	CategoryRecipe      = "recipe"
+	CategorySong        = "song"
+)
+
+// Categories lists every collectible category the catalog knows about
+var Categories = []string{CategoryFish, CategoryBug, CategorySeaCreature, CategoryFossil, CategoryArt, CategoryRecipe, CategorySong}
+
+// categoryNames are the display names of the categories
+var categoryNames = map[string]string{
+	CategoryFish:        "Fish",
+	CategoryBug:         "Bugs",
+	CategorySeaCreature: "Sea Creatures",
+	CategoryFossil:      "Fossils",
+	CategoryArt:         "Art",
+	CategoryRecipe:      "DIY Recipes",
+	CategorySong:        "K.K. Songs",
+}
+
+// CategoryName returns the display name of a category
+func CategoryName(category string) string {
+	if name, ok := categoryNames[category]; ok {
+		return name
+	}
+	return category
+}
+
+// IsCategory reports whether category is a known collectible category
+func IsCategory(category string) bool {
+	for _, c := range Categories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+// Donatable reports whether the museum takes the category's entries
+func Donatable(category string) bool {
+	return category != CategoryRecipe && category != CategorySong
+}
+
+// Collectible is a single catalog entry of any category. Fields every
+// category shares live on the struct; the rest go in Attributes, keyed by
+// the snake_case name of the typed field (e.g. "shadow_size").
+type Collectible struct {
+	Category          string                 `dynamodbav:"category"`
+	ID                string                 `dynamodbav:"id"`
+	Name              string                 `dynamodbav:"name"`
+	Icon              string                 `dynamodbav:"icon"`
+	SellPrice         int                    `dynamodbav:"sell_price"`
+	NorthAvailability []SeasonalAvailability `dynamodbav:"north_availability"`
+	SouthAvailability []SeasonalAvailability `dynamodbav:"south_availability"`
+	Attributes        map[string]string      `dynamodbav:"attributes"`
+	Caught            bool                   `dynamodbav:"-"`
+	CaughtAt          time.Time              `dynamodbav:"-"`
+	Donated           bool                   `dynamodbav:"-"`
+	DonatedAt         time.Time              `dynamodbav:"-"`
+	Duplicates        int                    `dynamodbav:"-"`
+}
+
+// WithProgress returns the entry merged with the user's progress on it
+func (c Collectible) WithProgress(p UserCollectible) Collectible {
+	c.Caught = p.Caught
+	c.CaughtAt = p.CaughtAt
+	c.Donated = p.Donated
+	c.DonatedAt = p.DonatedAt
+	c.Duplicates = p.Duplicates
+	return c
+}
+
+// Collectible converts the fish to its catalog entry
+func (f Fish) Collectible() Collectible {
+	return Collectible{
+		Category:          CategoryFish,
+		ID:                f.FishID,
+		Name:              f.Name,
+		Icon:              f.Icon,
+		SellPrice:         f.SellPrice,
+		NorthAvailability: f.NorthAvailability,
+		SouthAvailability: f.SouthAvailability,
+		Attributes: map[string]string{
+			"shadow_size": f.ShadowSize,
+			"shadow_icon": f.ShadowIcon,
+			"location":    f.Location,
+		},
+		Caught:  f.Caught,
+		Donated: f.Donated,
+	}
+}
+
+// FishFromCollectible converts a fish catalog entry back to a Fish
+func FishFromCollectible(c Collectible) Fish {
+	return Fish{
+		FishID:            c.ID,
+		Name:              c.Name,
+		Icon:              c.Icon,
+		SellPrice:         c.SellPrice,
+		ShadowSize:        c.Attributes["shadow_size"],
+		ShadowIcon:        c.Attributes["shadow_icon"],
+		Location:          c.Attributes["location"],
+		NorthAvailability: c.NorthAvailability,
+		SouthAvailability: c.SouthAvailability,
+		Caught:            c.Caught,
+		Donated:           c.Donated,
+	}
+}
+
+// Collectible converts the bug to its catalog entry
+func (b Bug) Collectible() Collectible {
+	return Collectible{
+		Category:          CategoryBug,
+		ID:                b.BugID,
+		Name:              b.Name,
+		Icon:              b.Icon,
+		SellPrice:         b.SellPrice,
+		NorthAvailability: b.NorthAvailability,
+		SouthAvailability: b.SouthAvailability,
+		Attributes: map[string]string{
+			"location": b.Location,
+			"weather":  b.Weather,
+		},
+		Caught:  b.Caught,
+		Donated: b.Donated,
+	}
+}
+
+// BugFromCollectible converts a bug catalog entry back to a Bug
+func BugFromCollectible(c Collectible) Bug {
+	return Bug{
+		BugID:             c.ID,
+		Name:              c.Name,
+		Icon:              c.Icon,
+		SellPrice:         c.SellPrice,
+		Location:          c.Attributes["location"],
+		Weather:           c.Attributes["weather"],
+		NorthAvailability: c.NorthAvailability,
+		SouthAvailability: c.SouthAvailability,
+		Caught:            c.Caught,
+		Donated:           c.Donated,
+	}
+}
+
+// Collectible converts the sea creature to its catalog entry
+func (s SeaCreature) Collectible() Collectible {
+	return Collectible{
+		Category:          CategorySeaCreature,
+		ID:                s.SeaCreatureID,
+		Name:              s.Name,
+		Icon:              s.Icon,
+		SellPrice:         s.SellPrice,
+		NorthAvailability: s.NorthAvailability,
+		SouthAvailability: s.SouthAvailability,
+		Attributes: map[string]string{
+			"shadow_size":    s.ShadowSize,
+			"shadow_icon":    s.ShadowIcon,
+			"swimming_speed": s.SwimmingSpeed,
+		},
+		Caught:  s.Caught,
+		Donated: s.Donated,
+	}
+}
+
+// SeaCreatureFromCollectible converts a sea creature catalog entry back to a
+// SeaCreature
+func SeaCreatureFromCollectible(c Collectible) SeaCreature {
+	return SeaCreature{
+		SeaCreatureID:     c.ID,
+		Name:              c.Name,
+		Icon:              c.Icon,
+		SellPrice:         c.SellPrice,
+		ShadowSize:        c.Attributes["shadow_size"],
+		ShadowIcon:        c.Attributes["shadow_icon"],
+		SwimmingSpeed:     c.Attributes["swimming_speed"],
+		NorthAvailability: c.NorthAvailability,
+		SouthAvailability: c.SouthAvailability,
+		Caught:            c.Caught,
+		Donated:           c.Donated,
+	}
+}
+
+// Collectible converts the fossil to its catalog entry. Fossils are always
+// available, so the entry has no availability.
+func (f Fossil) Collectible() Collectible {
+	return Collectible{
+		Category:  CategoryFossil,
+		ID:        f.FossilID,
+		Name:      f.Name,
+		Icon:      f.Icon,
+		SellPrice: f.SellPrice,
+		Attributes: map[string]string{
+			"skeleton": f.Skeleton,
+			"part":     f.Part,
+		},
+		Caught:     f.Assessed,
+		Donated:    f.Donated,
+		Duplicates: f.Duplicates,
+	}
+}
+
+// FossilFromCollectible converts a fossil catalog entry back to a Fossil
+func FossilFromCollectible(c Collectible) Fossil {
+	return Fossil{
+		FossilID:   c.ID,
+		Name:       c.Name,
+		Icon:       c.Icon,
+		SellPrice:  c.SellPrice,
+		Skeleton:   c.Attributes["skeleton"],
+		Part:       c.Attributes["part"],
+		Assessed:   c.Caught,
+		Donated:    c.Donated,
+		Duplicates: c.Duplicates,
+	}
+}
+
+// Collectible converts the art piece to its catalog entry. Art is always
+// available, so the entry has no availability.
+func (a Art) Collectible() Collectible {
+	return Collectible{
+		Category:  CategoryArt,
+		ID:        a.ArtID,
+		Name:      a.Name,
+		Icon:      a.Icon,
+		SellPrice: a.SellPrice,
+		Attributes: map[string]string{
+			"type":                a.Type,
+			"real_name":           a.RealName,
+			"artist":              a.Artist,
+			"has_forgery":         strconv.FormatBool(a.HasForgery),
+			"forgery_description": a.ForgeryDescription,
+		},
+		Donated: a.Donated,
+	}
+}
+
+// ArtFromCollectible converts an art catalog entry back to an Art
+func ArtFromCollectible(c Collectible) Art {
+	hasForgery, _ := strconv.ParseBool(c.Attributes["has_forgery"])
+	return Art{
+		ArtID:              c.ID,
+		Name:               c.Name,
+		Icon:               c.Icon,
+		SellPrice:          c.SellPrice,
+		Type:               c.Attributes["type"],
+		RealName:           c.Attributes["real_name"],
+		Artist:             c.Attributes["artist"],
+		HasForgery:         hasForgery,
+		ForgeryDescription: c.Attributes["forgery_description"],
+		Donated:            c.Donated,
+	}
+}
+
+// Collectible converts the recipe to its catalog entry. The materials are
+// stored JSON encoded in the "materials" attribute.
+func (r Recipe) Collectible() Collectible {
+	materials, _ := json.Marshal(r.Materials)
+	return Collectible{
+		Category: CategoryRecipe,
+		ID:       r.RecipeID,
+		Name:     r.Name,
+		Icon:     r.Icon,
+		Attributes: map[string]string{
+			"type":      r.Type,
+			"source":    r.Source,
+			"materials": string(materials),
+		},
+		Caught: r.Learned,
+	}
+}
+
+// RecipeFromCollectible converts a recipe catalog entry back to a Recipe
+func RecipeFromCollectible(c Collectible) Recipe {
+	var materials []Material
+	json.Unmarshal([]byte(c.Attributes["materials"]), &materials)
+	return Recipe{
+		RecipeID:  c.ID,
+		Name:      c.Name,
+		Icon:      c.Icon,
+		Type:      c.Attributes["type"],
+		Source:    c.Attributes["source"],
+		Materials: materials,
+		Learned:   c.Caught,
+	}
+}
+
+// Collectible converts the song to its catalog entry
+func (s Song) Collectible() Collectible {
+	return Collectible{
+		Category:  CategorySong,
+		ID:        s.SongID,
+		Name:      s.Name,
+		Icon:      s.Icon,
+		SellPrice: s.SellPrice,
+		Attributes: map[string]string{
+			"secret": strconv.FormatBool(s.Secret),
+		},
+		Caught: s.Owned,
+	}
+}
+
+// SongFromCollectible converts a song catalog entry back to a Song
+func SongFromCollectible(c Collectible) Song {
+	secret, _ := strconv.ParseBool(c.Attributes["secret"])
+	return Song{
+		SongID:    c.ID,
+		Name:      c.Name,
+		Icon:      c.Icon,
+		SellPrice: c.SellPrice,
+		Secret:    secret,
+		Owned:     c.Caught,
+	}
+}
+
+// Completion is a user's progress through one category, both in the
+// critterpedia (caught) and the museum (donated)
+type Completion struct {
+	Category string
+	Name     string
+	Total    int
+	Caught   int
+	Donated  int
+}
+
+// CaughtLabel names what Caught counts for the category
+func (c Completion) CaughtLabel() string {
+	switch c.Category {
+	case CategoryFossil:
+		return "Assessed"
+	case CategoryArt:
+		return "Acquired"
+	case CategorySong:
+		return "Owned"
+	}
+	return "Critterpedia"
+}
+
+// Donatable reports whether the category can be donated to the museum
+func (c Completion) Donatable() bool {
+	return Donatable(c.Category)
+}
+
+// CaughtPercent returns the caught share of the catalog, rounded down
+func (c Completion) CaughtPercent() int {
+	return percent(c.Caught, c.Total)
+}
+
+// DonatedPercent returns the donated share of the catalog, rounded down
+func (c Completion) DonatedPercent() int {
+	return percent(c.Donated, c.Total)
+}
+
+func percent(n, total int) int {
+	if total == 0 {
+		return 0
+	}
+	return n * 100 / total
+}
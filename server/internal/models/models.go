@@ -1,8 +1,18 @@
 package models
 
+import "time"
+
+// HemisphereUnset is the hemisphere of a profile that has not chosen one yet
+const HemisphereUnset = "unset"
+
 type User struct {
-	UserID     string `dynamodbav:"user_id"`
-	Hemisphere string `dynamodbav:"hemisphere"`
+	UserID          string      `dynamodbav:"user_id"`
+	Hemisphere      string      `dynamodbav:"hemisphere"`
+	ResidentHistory []Residency `dynamodbav:"resident_history"` // every move-in, oldest first
+	TimeZone        string      `dynamodbav:"time_zone"`    // IANA name, e.g. "Europe/London"
+	ClockOffset     int         `dynamodbav:"clock_offset"` // minutes the island clock runs ahead
+	CreatedAt       time.Time   `dynamodbav:"created_at"`
+	UpdatedAt       time.Time   `dynamodbav:"updated_at"`
 }
 
 type Fish struct {
@@ -16,12 +26,78 @@ type Fish struct {
 	NorthAvailability []SeasonalAvailability `dynamodbav:"north_availability"`
 	SouthAvailability []SeasonalAvailability `dynamodbav:"south_availability"`
 	Caught            bool                   `json:"Caught"` 
+	Donated           bool                   `json:"Donated"`
 }
 
-type UserFish struct {
-	UserID string `dynamodbav:"user_id"`
-	FishID string `dynamodbav:"fish_id"`
-	Owned  bool   `dynamodbav:"owned"`
+type Bug struct {
+	BugID             string                 `dynamodbav:"bug_id"`
+	Name              string                 `dynamodbav:"name"`
+	Icon              string                 `dynamodbav:"icon"`
+	SellPrice         int                    `dynamodbav:"sell_price"`
+	Location          string                 `dynamodbav:"location"`
+	Weather           string                 `dynamodbav:"weather"` // "Any", "Any except rain" or "Rain only"
+	NorthAvailability []SeasonalAvailability `dynamodbav:"north_availability"`
+	SouthAvailability []SeasonalAvailability `dynamodbav:"south_availability"`
+	Caught            bool                   `json:"Caught"`
+	Donated           bool                   `json:"Donated"`
+}
+
+type SeaCreature struct {
+	SeaCreatureID     string                 `dynamodbav:"sea_creature_id"`
+	Name              string                 `dynamodbav:"name"`
+	Icon              string                 `dynamodbav:"icon"`
+	SellPrice         int                    `dynamodbav:"sell_price"`
+	ShadowSize        string                 `dynamodbav:"shadow_size"`
+	ShadowIcon        string                 `dynamodbav:"shadow_icon"`
+	SwimmingSpeed     string                 `dynamodbav:"swimming_speed"` // "Stationary" through "Fast"
+	NorthAvailability []SeasonalAvailability `dynamodbav:"north_availability"`
+	SouthAvailability []SeasonalAvailability `dynamodbav:"south_availability"`
+	Caught            bool                   `json:"Caught"`
+	Donated           bool                   `json:"Donated"`
+}
+
+// Fossil is one fossil. Parts of a multi-part skeleton share a Skeleton name;
+// a standalone fossil is its own skeleton and has no Part.
+type Fossil struct {
+	FossilID   string `dynamodbav:"fossil_id"`
+	Name       string `dynamodbav:"name"`
+	Icon       string `dynamodbav:"icon"`
+	SellPrice  int    `dynamodbav:"sell_price"`
+	Skeleton   string `dynamodbav:"skeleton"`
+	Part       string `dynamodbav:"part"` // e.g. "skull", empty for standalone fossils
+	Assessed   bool   `json:"Assessed"`
+	Donated    bool   `json:"Donated"`
+	Duplicates int    `json:"Duplicates"`
+}
+
+// Art is a painting or statue from Redd's. Pieces with HasForgery set can
+// turn up as fakes, which the museum won't accept.
+type Art struct {
+	ArtID              string `dynamodbav:"art_id"`
+	Name               string `dynamodbav:"name"`
+	Icon               string `dynamodbav:"icon"`
+	SellPrice          int    `dynamodbav:"sell_price"`
+	Type               string `dynamodbav:"type"` // "painting" or "statue"
+	RealName           string `dynamodbav:"real_name"`
+	Artist             string `dynamodbav:"artist"`
+	HasForgery         bool   `dynamodbav:"has_forgery"`
+	ForgeryDescription string `dynamodbav:"forgery_description"` // how to tell the fake apart
+	Donated            bool   `json:"Donated"`
+}
+
+// UserCollectible is a user's progress on a single collectible. Catching
+// and donating to the museum are separate milestones. Fossils are never
+// caught, so for them Caught means assessed; Duplicates counts the spare
+// copies a user is holding on to.
+type UserCollectible struct {
+	UserID     string    `dynamodbav:"user_id"`
+	Category   string    `dynamodbav:"category"`
+	ID         string    `dynamodbav:"id"`
+	Caught     bool      `dynamodbav:"caught"`
+	CaughtAt   time.Time `dynamodbav:"caught_at"`
+	Donated    bool      `dynamodbav:"donated"`
+	DonatedAt  time.Time `dynamodbav:"donated_at"`
+	Duplicates int       `dynamodbav:"duplicates"`
 }
 
 type TimeRange struct {
@@ -33,3 +109,19 @@ type SeasonalAvailability struct {
 	Months     []int       `dynamodbav:"months"`      // e.g. [3, 4, 5, 6]
 	TimeRanges []TimeRange `dynamodbav:"time_ranges"` // 1+ ranges
 }
+
+// Credential is an account registered with the local auth provider
+type Credential struct {
+	Sub              string
+	Email            string
+	PasswordHash     string
+	Confirmed        bool
+	ConfirmationCode string
+	CodeExpiresAt    time.Time
+	CodeAttempts     int // wrong confirmation codes entered since the code was issued
+	ResetCode        string
+	ResetExpiresAt   time.Time
+	ResetAttempts    int // wrong reset codes entered since the code was issued
+	// TokensValidAfter invalidates every token issued before it
+	TokensValidAfter time.Time
+}
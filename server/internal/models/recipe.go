@@ -0,0 +1,37 @@
+package models
+
+import "strings"
+
+// Material is an ingredient of a DIY recipe
+type Material struct {
+	Name     string `json:"name"`
+	Quantity int    `json:"quantity"`
+}
+
+// Recipe is a DIY recipe
+type Recipe struct {
+	RecipeID  string     `dynamodbav:"recipe_id"`
+	Name      string     `dynamodbav:"name"`
+	Icon      string     `dynamodbav:"icon"`
+	Type      string     `dynamodbav:"type"`   // "Tools", "Housewares", "Miscellaneous"...
+	Source    string     `dynamodbav:"source"` // where the recipe card comes from
+	Materials []Material `dynamodbav:"materials"`
+	Learned   bool       `json:"Learned"`
+}
+
+// Missing returns the materials the inventory is short of, with the shortfall
+// as the quantity. Inventory names match case-insensitively.
+func (r Recipe) Missing(inventory map[string]int) []Material {
+	have := make(map[string]int, len(inventory))
+	for name, quantity := range inventory {
+		have[strings.ToLower(name)] += quantity
+	}
+
+	var missing []Material
+	for _, m := range r.Materials {
+		if short := m.Quantity - have[strings.ToLower(m.Name)]; short > 0 {
+			missing = append(missing, Material{Name: m.Name, Quantity: short})
+		}
+	}
+	return missing
+}
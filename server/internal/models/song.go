@@ -0,0 +1,12 @@
+package models
+
+// Song is a K.K. Slider song. Secret songs are never played at random on a
+// Saturday night; the only way to get them is to request them by name.
+type Song struct {
+	SongID    string `dynamodbav:"song_id"`
+	Name      string `dynamodbav:"name"`
+	Icon      string `dynamodbav:"icon"`
+	SellPrice int    `dynamodbav:"sell_price"`
+	Secret    bool   `dynamodbav:"secret"`
+	Owned     bool   `json:"Owned"`
+}
@@ -0,0 +1,101 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+// MaxResidents is how many villagers an island can house at once
+const MaxResidents = 10
+
+var (
+	// ErrRosterFull is returned when a villager moves onto a full island
+	ErrRosterFull = errors.New("the island already has 10 residents")
+	// ErrAlreadyResident is returned when a resident moves in again
+	ErrAlreadyResident = errors.New("the villager already lives on the island")
+	// ErrNotResident is returned when a villager who doesn't live on the
+	// island moves out
+	ErrNotResident = errors.New("the villager does not live on the island")
+)
+
+// Villager is an entry in the villager directory
+type Villager struct {
+	VillagerID  string `dynamodbav:"villager_id"`
+	Name        string `dynamodbav:"name"`
+	Icon        string `dynamodbav:"icon"`
+	Species     string `dynamodbav:"species"`
+	Personality string `dynamodbav:"personality"`
+	BirthMonth  int    `dynamodbav:"birth_month"`
+	BirthDay    int    `dynamodbav:"birth_day"`
+	Hobby       string `dynamodbav:"hobby"`
+	Catchphrase string `dynamodbav:"catchphrase"`
+}
+
+// Residency is one villager's stay on a user's island. MovedOutAt is zero
+// while the villager still lives there.
+type Residency struct {
+	VillagerID string    `dynamodbav:"villager_id"`
+	MovedInAt  time.Time `dynamodbav:"moved_in_at"`
+	MovedOutAt time.Time `dynamodbav:"moved_out_at"`
+}
+
+// Current reports whether the villager still lives on the island
+func (r Residency) Current() bool {
+	return r.MovedOutAt.IsZero()
+}
+
+// Residents returns the IDs of the villagers living on the island, in
+// move-in order
+func (u User) Residents() []string {
+	var residents []string
+	for _, r := range u.ResidentHistory {
+		if r.Current() {
+			residents = append(residents, r.VillagerID)
+		}
+	}
+	return residents
+}
+
+// Hosted returns the IDs of every villager who has lived on the island, in
+// order of their first move-in
+func (u User) Hosted() []string {
+	var hosted []string
+	seen := make(map[string]bool)
+	for _, r := range u.ResidentHistory {
+		if !seen[r.VillagerID] {
+			seen[r.VillagerID] = true
+			hosted = append(hosted, r.VillagerID)
+		}
+	}
+	return hosted
+}
+
+// MoveIn records the villager moving onto the island
+func (u *User) MoveIn(villagerID string, at time.Time) error {
+	residents := u.Residents()
+	for _, id := range residents {
+		if id == villagerID {
+			return ErrAlreadyResident
+		}
+	}
+	if len(residents) >= MaxResidents {
+		return ErrRosterFull
+	}
+
+	u.ResidentHistory = append(u.ResidentHistory, Residency{
+		VillagerID: villagerID,
+		MovedInAt:  at,
+	})
+	return nil
+}
+
+// MoveOut records the villager leaving the island
+func (u *User) MoveOut(villagerID string, at time.Time) error {
+	for i, r := range u.ResidentHistory {
+		if r.VillagerID == villagerID && r.Current() {
+			u.ResidentHistory[i].MovedOutAt = at
+			return nil
+		}
+	}
+	return ErrNotResident
+}
@@ -0,0 +1,63 @@
+package ratelimit
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func testLimiter(limit int, window time.Duration) (*Limiter, *time.Time) {
+	now := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	l := New(limit, window)
+	l.now = func() time.Time { return now }
+	return l, &now
+}
+
+func TestAllowLimitsPerKey(t *testing.T) {
+	l, now := testLimiter(2, time.Minute)
+
+	for i, want := range []bool{true, true, false, false} {
+		if got := l.Allow("a"); got != want {
+			t.Fatalf("Allow(a) #%d = %v, want %v", i+1, got, want)
+		}
+	}
+	if !l.Allow("b") {
+		t.Fatal("Allow(b) = false; keys should be limited separately")
+	}
+
+	// The first events leave the window a full window after they happened
+	*now = now.Add(time.Minute - time.Second)
+	if l.Allow("a") {
+		t.Fatal("Allow(a) inside the window = true")
+	}
+	*now = now.Add(time.Second)
+	if !l.Allow("a") {
+		t.Fatal("Allow(a) after the window = false")
+	}
+}
+
+func TestSweepDropsIdleKeysOncePerWindow(t *testing.T) {
+	l, now := testLimiter(1, time.Minute)
+
+	for i := range 100 {
+		l.Allow(fmt.Sprintf("key-%d", i))
+	}
+	if got := len(l.events); got != 100 {
+		t.Fatalf("tracked keys = %d, want 100", got)
+	}
+
+	*now = now.Add(time.Minute)
+	l.Allow("fresh")
+	if got := len(l.events); got != 1 {
+		t.Fatalf("tracked keys after a window = %d, want 1", got)
+	}
+
+	// Within the next window idle keys are left for the next sweep
+	*now = now.Add(time.Second)
+	l.Allow("other")
+	*now = now.Add(time.Minute - 2*time.Second)
+	l.Allow("third")
+	if got := len(l.events); got != 3 {
+		t.Fatalf("tracked keys before the next sweep = %d, want 3", got)
+	}
+}
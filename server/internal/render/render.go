@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"path/filepath"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/mcgigglepop/acnh-finder/server/internal/config"
@@ -34,6 +35,7 @@ var functions = template.FuncMap{
 	"len": func(x interface{}) int {
 		return reflect.ValueOf(x).Len()
 	},
+	"hasPrefix": strings.HasPrefix,
 	"lt": func(a, b int) bool {
 		return a < b
 	},
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/availability"
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+)
+
+// ErrNotFound is returned when a requested record does not exist
+var ErrNotFound = errors.New("not found")
+
+// ErrConflict is returned when a record changed while it was being updated;
+// the caller may retry
+var ErrConflict = errors.New("conflicting update")
+
+// UserProfileStore reads and writes user profiles
+type UserProfileStore interface {
+	// CreateUserProfile creates the profile with default settings if it does
+	// not exist yet. It is safe to call on every login.
+	CreateUserProfile(ctx context.Context, userSub string) error
+	// GetUserProfile returns ErrNotFound if the profile has not been created
+	GetUserProfile(ctx context.Context, userSub string) (*models.User, error)
+	UpdateUserHemisphere(ctx context.Context, userSub string, hemisphere string) error
+	// UpdateUserClock sets the user's time zone and island clock offset in
+	// minutes
+	UpdateUserClock(ctx context.Context, userSub string, timeZone string, clockOffset int) error
+	// MoveInVillager and MoveOutVillager update the island roster. They return
+	// models.ErrRosterFull, models.ErrAlreadyResident or models.ErrNotResident
+	// when the move isn't possible.
+	MoveInVillager(ctx context.Context, userSub, villagerID string) error
+	MoveOutVillager(ctx context.Context, userSub, villagerID string) error
+}
+
+// VillagerStore serves the villager directory
+type VillagerStore interface {
+	// ListVillagers returns the whole directory in seed order
+	ListVillagers(ctx context.Context) ([]models.Villager, error)
+	// GetVillager returns ErrNotFound for an unknown ID
+	GetVillager(ctx context.Context, villagerID string) (*models.Villager, error)
+}
+
+// CatalogStore serves the collectible catalogs, merged with a user's caught
+// state
+type CatalogStore interface {
+	// ListAvailable returns the category's entries available in the given
+	// hemisphere at the month and time of day
+	ListAvailable(ctx context.Context, category, userID string, month int, at availability.Clock, hemisphere string) ([]models.Collectible, error)
+	// ListCollectibles returns the category's whole catalog in seed order,
+	// regardless of availability
+	ListCollectibles(ctx context.Context, category, userID string) ([]models.Collectible, error)
+	// CountCollectibles returns the size of the category's catalog
+	CountCollectibles(ctx context.Context, category string) (int, error)
+	// GetCollectible returns the catalog entry without any user's progress,
+	// or ErrNotFound for an unknown ID
+	GetCollectible(ctx context.Context, category, id string) (*models.Collectible, error)
+}
+
+// CollectionStore tracks which collectibles a user has caught and which they
+// have donated to the museum. A donation implies a catch: PutDonated also
+// marks the entry caught and DeleteCaught also withdraws the donation.
+// Timestamps record when each state was first set.
+type CollectionStore interface {
+	PutCaught(ctx context.Context, userID, category, id string) error
+	DeleteCaught(ctx context.Context, userID, category, id string) error
+	PutDonated(ctx context.Context, userID, category, id string) error
+	DeleteDonated(ctx context.Context, userID, category, id string) error
+	CountCaught(ctx context.Context, userID, category string) (int, error)
+	CountDonated(ctx context.Context, userID, category string) (int, error)
+	// SetDuplicates records how many spare copies of an entry the user holds.
+	// A positive count also marks the entry caught.
+	SetDuplicates(ctx context.Context, userID, category, id string, count int) error
+	// CountDuplicates returns the total spare copies across the category
+	CountDuplicates(ctx context.Context, userID, category string) (int, error)
+}
+
+// CredentialStore persists accounts for the local auth provider
+type CredentialStore interface {
+	GetCredential(ctx context.Context, email string) (*models.Credential, error)
+	PutCredential(ctx context.Context, cred models.Credential) error
+	RevokeToken(ctx context.Context, tokenID string, expiresAt time.Time) error
+	IsTokenRevoked(ctx context.Context, tokenID string) (bool, error)
+}
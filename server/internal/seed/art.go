@@ -0,0 +1,455 @@
+package seed
+
+import "github.com/mcgigglepop/acnh-finder/server/internal/models"
+
+// Art returns every painting and statue Redd sells. Pieces without a
+// ForgeryDescription are always genuine.
+func Art() []models.Art {
+	return []models.Art{
+		{
+			ArtID:              "1-academic-painting",
+			Name:               "Academic painting",
+			Icon:               "/static/images/art/icons/academic-painting.png",
+			SellPrice:          1245,
+			Type:               "painting",
+			RealName:           "Vitruvian Man",
+			Artist:             "Leonardo da Vinci",
+			HasForgery:         true,
+			ForgeryDescription: "The fake has a coffee stain in the top-right corner.",
+		},
+		{
+			ArtID:              "2-amazing-painting",
+			Name:               "Amazing painting",
+			Icon:               "/static/images/art/icons/amazing-painting.png",
+			SellPrice:          1245,
+			Type:               "painting",
+			RealName:           "The Night Watch",
+			Artist:             "Rembrandt van Rijn",
+			HasForgery:         true,
+			ForgeryDescription: "The man in the front with the red sash is missing his hat.",
+		},
+		{
+			ArtID:              "3-basic-painting",
+			Name:               "Basic painting",
+			Icon:               "/static/images/art/icons/basic-painting.png",
+			SellPrice:          1245,
+			Type:               "painting",
+			RealName:           "The Blue Boy",
+			Artist:             "Thomas Gainsborough",
+			HasForgery:         true,
+			ForgeryDescription: "The boy has bangs; on the real painting his hair is swept back.",
+		},
+		{
+			ArtID:     "4-calm-painting",
+			Name:      "Calm painting",
+			Icon:      "/static/images/art/icons/calm-painting.png",
+			SellPrice: 1245,
+			Type:      "painting",
+			RealName:  "A Sunday Afternoon on the Island of La Grande Jatte",
+			Artist:    "Georges Seurat",
+		},
+		{
+			ArtID:     "5-common-painting",
+			Name:      "Common painting",
+			Icon:      "/static/images/art/icons/common-painting.png",
+			SellPrice: 1245,
+			Type:      "painting",
+			RealName:  "The Gleaners",
+			Artist:    "Jean-François Millet",
+		},
+		{
+			ArtID:              "6-detailed-painting",
+			Name:               "Detailed painting",
+			Icon:               "/static/images/art/icons/detailed-painting.png",
+			SellPrice:          1245,
+			Type:               "painting",
+			RealName:           "Hydrangeas and Swallow",
+			Artist:             "Itō Jakuchū",
+			HasForgery:         true,
+			ForgeryDescription: "The hydrangeas are purple instead of blue.",
+		},
+		{
+			ArtID:     "7-dynamic-painting",
+			Name:      "Dynamic painting",
+			Icon:      "/static/images/art/icons/dynamic-painting.png",
+			SellPrice: 1245,
+			Type:      "painting",
+			RealName:  "The Great Wave off Kanagawa",
+			Artist:    "Katsushika Hokusai",
+		},
+		{
+			ArtID:              "8-famous-painting",
+			Name:               "Famous painting",
+			Icon:               "/static/images/art/icons/famous-painting.png",
+			SellPrice:          1245,
+			Type:               "painting",
+			RealName:           "Mona Lisa",
+			Artist:             "Leonardo da Vinci",
+			HasForgery:         true,
+			ForgeryDescription: "Her eyebrows are raised at the outer ends.",
+		},
+		{
+			ArtID:     "9-flowery-painting",
+			Name:      "Flowery painting",
+			Icon:      "/static/images/art/icons/flowery-painting.png",
+			SellPrice: 1245,
+			Type:      "painting",
+			RealName:  "Sunflowers",
+			Artist:    "Vincent van Gogh",
+		},
+		{
+			ArtID:     "10-glowing-painting",
+			Name:      "Glowing painting",
+			Icon:      "/static/images/art/icons/glowing-painting.png",
+			SellPrice: 1245,
+			Type:      "painting",
+			RealName:  "The Fighting Temeraire",
+			Artist:    "J. M. W. Turner",
+		},
+		{
+			ArtID:              "11-graceful-painting",
+			Name:               "Graceful painting",
+			Icon:               "/static/images/art/icons/graceful-painting.png",
+			SellPrice:          1245,
+			Type:               "painting",
+			RealName:           "Beauty Looking Back",
+			Artist:             "Hishikawa Moronobu",
+			HasForgery:         true,
+			ForgeryDescription: "Her hair ornament differs; check the back of her head against the original.",
+		},
+		{
+			ArtID:              "12-jolly-painting",
+			Name:               "Jolly painting",
+			Icon:               "/static/images/art/icons/jolly-painting.png",
+			SellPrice:          1245,
+			Type:               "painting",
+			RealName:           "Summer",
+			Artist:             "Giuseppe Arcimboldo",
+			HasForgery:         true,
+			ForgeryDescription: "The flower on the chest has been swapped for a mushroom.",
+		},
+		{
+			ArtID:     "13-moody-painting",
+			Name:      "Moody painting",
+			Icon:      "/static/images/art/icons/moody-painting.png",
+			SellPrice: 1245,
+			Type:      "painting",
+			RealName:  "The Sower",
+			Artist:    "Jean-François Millet",
+		},
+		{
+			ArtID:              "14-moving-painting",
+			Name:               "Moving painting",
+			Icon:               "/static/images/art/icons/moving-painting.png",
+			SellPrice:          1245,
+			Type:               "painting",
+			RealName:           "The Birth of Venus",
+			Artist:             "Sandro Botticelli",
+			HasForgery:         true,
+			ForgeryDescription: "The trees on the right are bare of leaves.",
+		},
+		{
+			ArtID:              "15-mysterious-painting",
+			Name:               "Mysterious painting",
+			Icon:               "/static/images/art/icons/mysterious-painting.png",
+			SellPrice:          1245,
+			Type:               "painting",
+			RealName:           "Isle of the Dead",
+			Artist:             "Arnold Böcklin",
+			HasForgery:         true,
+			ForgeryDescription: "The cypress trees on the island are in bloom.",
+		},
+		{
+			ArtID:     "16-nice-painting",
+			Name:      "Nice painting",
+			Icon:      "/static/images/art/icons/nice-painting.png",
+			SellPrice: 1245,
+			Type:      "painting",
+			RealName:  "The Fifer",
+			Artist:    "Édouard Manet",
+		},
+		{
+			ArtID:     "17-perfect-painting",
+			Name:      "Perfect painting",
+			Icon:      "/static/images/art/icons/perfect-painting.png",
+			SellPrice: 1245,
+			Type:      "painting",
+			RealName:  "Apples and Oranges",
+			Artist:    "Paul Cézanne",
+		},
+		{
+			ArtID:     "18-proper-painting",
+			Name:      "Proper painting",
+			Icon:      "/static/images/art/icons/proper-painting.png",
+			SellPrice: 1245,
+			Type:      "painting",
+			RealName:  "A Bar at the Folies-Bergère",
+			Artist:    "Édouard Manet",
+		},
+		{
+			ArtID:              "19-quaint-painting",
+			Name:               "Quaint painting",
+			Icon:               "/static/images/art/icons/quaint-painting.png",
+			SellPrice:          1245,
+			Type:               "painting",
+			RealName:           "The Milkmaid",
+			Artist:             "Johannes Vermeer",
+			HasForgery:         true,
+			ForgeryDescription: "The milk pours out in a much thicker stream.",
+		},
+		{
+			ArtID:              "20-scary-painting",
+			Name:               "Scary painting",
+			Icon:               "/static/images/art/icons/scary-painting.png",
+			SellPrice:          1245,
+			Type:               "painting",
+			RealName:           "Otani Oniji III as Yakko Edobei",
+			Artist:             "Tōshūsai Sharaku",
+			HasForgery:         true,
+			ForgeryDescription: "His eyebrows slant downward, making him look cheerful.",
+		},
+		{
+			ArtID:              "21-scenic-painting",
+			Name:               "Scenic painting",
+			Icon:               "/static/images/art/icons/scenic-painting.png",
+			SellPrice:          1245,
+			Type:               "painting",
+			RealName:           "Hunters in the Snow",
+			Artist:             "Pieter Bruegel the Elder",
+			HasForgery:         true,
+			ForgeryDescription: "The hunters in the foreground are different; compare their shapes against the original.",
+		},
+		{
+			ArtID:              "22-serene-painting",
+			Name:               "Serene painting",
+			Icon:               "/static/images/art/icons/serene-painting.png",
+			SellPrice:          1245,
+			Type:               "painting",
+			RealName:           "Lady with an Ermine",
+			Artist:             "Leonardo da Vinci",
+			HasForgery:         true,
+			ForgeryDescription: "The ermine is gray instead of white.",
+		},
+		{
+			ArtID:     "23-sinking-painting",
+			Name:      "Sinking painting",
+			Icon:      "/static/images/art/icons/sinking-painting.png",
+			SellPrice: 1245,
+			Type:      "painting",
+			RealName:  "Ophelia",
+			Artist:    "John Everett Millais",
+		},
+		{
+			ArtID:              "24-solemn-painting",
+			Name:               "Solemn painting",
+			Icon:               "/static/images/art/icons/solemn-painting.png",
+			SellPrice:          1245,
+			Type:               "painting",
+			RealName:           "Las Meninas",
+			Artist:             "Diego Velázquez",
+			HasForgery:         true,
+			ForgeryDescription: "The man in the doorway at the back has his arm raised.",
+		},
+		{
+			ArtID:     "25-twinkling-painting",
+			Name:      "Twinkling painting",
+			Icon:      "/static/images/art/icons/twinkling-painting.png",
+			SellPrice: 1245,
+			Type:      "painting",
+			RealName:  "The Starry Night",
+			Artist:    "Vincent van Gogh",
+		},
+		{
+			ArtID:     "26-warm-painting",
+			Name:      "Warm painting",
+			Icon:      "/static/images/art/icons/warm-painting.png",
+			SellPrice: 1245,
+			Type:      "painting",
+			RealName:  "The Child's Bath",
+			Artist:    "Mary Cassatt",
+		},
+		{
+			ArtID:              "27-wild-painting-left-half",
+			Name:               "Wild painting left half",
+			Icon:               "/static/images/art/icons/wild-painting-left-half.png",
+			SellPrice:          1245,
+			Type:               "painting",
+			RealName:           "Wind God and Thunder God (left)",
+			Artist:             "Tawaraya Sōtatsu",
+			HasForgery:         true,
+			ForgeryDescription: "The wind god is white instead of green.",
+		},
+		{
+			ArtID:              "28-wild-painting-right-half",
+			Name:               "Wild painting right half",
+			Icon:               "/static/images/art/icons/wild-painting-right-half.png",
+			SellPrice:          1245,
+			Type:               "painting",
+			RealName:           "Wind God and Thunder God (right)",
+			Artist:             "Tawaraya Sōtatsu",
+			HasForgery:         true,
+			ForgeryDescription: "The thunder god is green instead of white.",
+		},
+		{
+			ArtID:              "29-wistful-painting",
+			Name:               "Wistful painting",
+			Icon:               "/static/images/art/icons/wistful-painting.png",
+			SellPrice:          1245,
+			Type:               "painting",
+			RealName:           "Girl with a Pearl Earring",
+			Artist:             "Johannes Vermeer",
+			HasForgery:         true,
+			ForgeryDescription: "The earring is star-shaped.",
+		},
+		{
+			ArtID:              "30-worthy-painting",
+			Name:               "Worthy painting",
+			Icon:               "/static/images/art/icons/worthy-painting.png",
+			SellPrice:          1245,
+			Type:               "painting",
+			RealName:           "Liberty Leading the People",
+			Artist:             "Eugène Delacroix",
+			HasForgery:         true,
+			ForgeryDescription: "The flag and the figures around Liberty differ from the original.",
+		},
+		{
+			ArtID:              "31-ancient-statue",
+			Name:               "Ancient statue",
+			Icon:               "/static/images/art/icons/ancient-statue.png",
+			SellPrice:          1245,
+			Type:               "statue",
+			RealName:           "Jōmon dogū",
+			Artist:             "Unknown",
+			HasForgery:         true,
+			ForgeryDescription: "The fake has antennae on its head.",
+		},
+		{
+			ArtID:              "32-beautiful-statue",
+			Name:               "Beautiful statue",
+			Icon:               "/static/images/art/icons/beautiful-statue.png",
+			SellPrice:          1245,
+			Type:               "statue",
+			RealName:           "Venus de Milo",
+			Artist:             "Alexandros of Antioch",
+			HasForgery:         true,
+			ForgeryDescription: "The fake is wearing a necklace.",
+		},
+		{
+			ArtID:     "33-familiar-statue",
+			Name:      "Familiar statue",
+			Icon:      "/static/images/art/icons/familiar-statue.png",
+			SellPrice: 1245,
+			Type:      "statue",
+			RealName:  "The Thinker",
+			Artist:    "Auguste Rodin",
+		},
+		{
+			ArtID:              "34-gallant-statue",
+			Name:               "Gallant statue",
+			Icon:               "/static/images/art/icons/gallant-statue.png",
+			SellPrice:          1245,
+			Type:               "statue",
+			RealName:           "David",
+			Artist:             "Michelangelo",
+			HasForgery:         true,
+			ForgeryDescription: "The fake is holding a book under its arm.",
+		},
+		{
+			ArtID:     "35-great-statue",
+			Name:      "Great statue",
+			Icon:      "/static/images/art/icons/great-statue.png",
+			SellPrice: 1245,
+			Type:      "statue",
+			RealName:  "King Kamehameha I",
+			Artist:    "Thomas Ridgeway Gould",
+		},
+		{
+			ArtID:              "36-informative-statue",
+			Name:               "Informative statue",
+			Icon:               "/static/images/art/icons/informative-statue.png",
+			SellPrice:          1245,
+			Type:               "statue",
+			RealName:           "Rosetta Stone",
+			Artist:             "Unknown",
+			HasForgery:         true,
+			ForgeryDescription: "The fake is blue instead of gray.",
+		},
+		{
+			ArtID:              "37-motherly-statue",
+			Name:               "Motherly statue",
+			Icon:               "/static/images/art/icons/motherly-statue.png",
+			SellPrice:          1245,
+			Type:               "statue",
+			RealName:           "Capitoline Wolf",
+			Artist:             "Unknown",
+			HasForgery:         true,
+			ForgeryDescription: "The wolf has its tongue sticking out.",
+		},
+		{
+			ArtID:              "38-mystic-statue",
+			Name:               "Mystic statue",
+			Icon:               "/static/images/art/icons/mystic-statue.png",
+			SellPrice:          1245,
+			Type:               "statue",
+			RealName:           "Bust of Nefertiti",
+			Artist:             "Thutmose",
+			HasForgery:         true,
+			ForgeryDescription: "The fake is wearing earrings.",
+		},
+		{
+			ArtID:              "39-robust-statue",
+			Name:               "Robust statue",
+			Icon:               "/static/images/art/icons/robust-statue.png",
+			SellPrice:          1245,
+			Type:               "statue",
+			RealName:           "Discobolus",
+			Artist:             "Myron",
+			HasForgery:         true,
+			ForgeryDescription: "The fake is wearing a wristwatch.",
+		},
+		{
+			ArtID:              "40-rock-head-statue",
+			Name:               "Rock-head statue",
+			Icon:               "/static/images/art/icons/rock-head-statue.png",
+			SellPrice:          1245,
+			Type:               "statue",
+			RealName:           "Olmec colossal head",
+			Artist:             "Unknown",
+			HasForgery:         true,
+			ForgeryDescription: "The fake is smiling.",
+		},
+		{
+			ArtID:              "41-tremendous-statue",
+			Name:               "Tremendous statue",
+			Icon:               "/static/images/art/icons/tremendous-statue.png",
+			SellPrice:          1245,
+			Type:               "statue",
+			RealName:           "Houmuwu ding",
+			Artist:             "Unknown",
+			HasForgery:         true,
+			ForgeryDescription: "The fake has a lid on top.",
+		},
+		{
+			ArtID:              "42-valiant-statue",
+			Name:               "Valiant statue",
+			Icon:               "/static/images/art/icons/valiant-statue.png",
+			SellPrice:          1245,
+			Type:               "statue",
+			RealName:           "Winged Victory of Samothrace",
+			Artist:             "Unknown",
+			HasForgery:         true,
+			ForgeryDescription: "The fake has one foot stepping forward.",
+		},
+		{
+			ArtID:              "43-warrior-statue",
+			Name:               "Warrior statue",
+			Icon:               "/static/images/art/icons/warrior-statue.png",
+			SellPrice:          1245,
+			Type:               "statue",
+			RealName:           "Terracotta Army",
+			Artist:             "Unknown",
+			HasForgery:         true,
+			ForgeryDescription: "The fake is holding a shovel.",
+		},
+	}
+}
@@ -0,0 +1,1933 @@
+package seed
+
+import "github.com/mcgigglepop/acnh-finder/server/internal/models"
+
+// Bugs returns the full bug catalog for both hemispheres
+func Bugs() []models.Bug {
+	return []models.Bug{
+		{
+			BugID:     "1-common-butterfly",
+			Name:      "Common Butterfly",
+			Icon:      "/static/images/bugs/icons/common-butterfly.png",
+			SellPrice: 160,
+			Location:  "Flying",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "04:00", End: "19:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "04:00", End: "19:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "2-yellow-butterfly",
+			Name:      "Yellow Butterfly",
+			Icon:      "/static/images/bugs/icons/yellow-butterfly.png",
+			SellPrice: 160,
+			Location:  "Flying",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4, 5, 6, 9, 10},
+					TimeRanges: []models.TimeRange{
+						{Start: "04:00", End: "19:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "04:00", End: "19:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "3-tiger-butterfly",
+			Name:      "Tiger Butterfly",
+			Icon:      "/static/images/bugs/icons/tiger-butterfly.png",
+			SellPrice: 240,
+			Location:  "Flying",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4, 5, 6, 7, 8, 9},
+					TimeRanges: []models.TimeRange{
+						{Start: "04:00", End: "19:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "04:00", End: "19:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "4-peacock-butterfly",
+			Name:      "Peacock Butterfly",
+			Icon:      "/static/images/bugs/icons/peacock-butterfly.png",
+			SellPrice: 2500,
+			Location:  "Flying near blue, purple and black flowers",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4, 5, 6},
+					TimeRanges: []models.TimeRange{
+						{Start: "04:00", End: "19:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "04:00", End: "19:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "5-common-bluebottle",
+			Name:      "Common Bluebottle",
+			Icon:      "/static/images/bugs/icons/common-bluebottle.png",
+			SellPrice: 300,
+			Location:  "Flying",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{4, 5, 6, 7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "04:00", End: "19:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "04:00", End: "19:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "6-paper-kite-butterfly",
+			Name:      "Paper Kite Butterfly",
+			Icon:      "/static/images/bugs/icons/paper-kite-butterfly.png",
+			SellPrice: 1000,
+			Location:  "Flying",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "19:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "19:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "7-great-purple-emperor",
+			Name:      "Great Purple Emperor",
+			Icon:      "/static/images/bugs/icons/great-purple-emperor.png",
+			SellPrice: 3000,
+			Location:  "Flying",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{5, 6, 7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "04:00", End: "19:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "04:00", End: "19:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "8-monarch-butterfly",
+			Name:      "Monarch Butterfly",
+			Icon:      "/static/images/bugs/icons/monarch-butterfly.png",
+			SellPrice: 140,
+			Location:  "Flying",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{9, 10, 11},
+					TimeRanges: []models.TimeRange{
+						{Start: "04:00", End: "17:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4, 5},
+					TimeRanges: []models.TimeRange{
+						{Start: "04:00", End: "17:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "9-emperor-butterfly",
+			Name:      "Emperor Butterfly",
+			Icon:      "/static/images/bugs/icons/emperor-butterfly.png",
+			SellPrice: 4000,
+			Location:  "Flying",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 6, 7, 8, 9, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "17:00", End: "08:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 6, 7, 8, 9, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "17:00", End: "08:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "10-agrias-butterfly",
+			Name:      "Agrias Butterfly",
+			Icon:      "/static/images/bugs/icons/agrias-butterfly.png",
+			SellPrice: 3000,
+			Location:  "Flying",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{4, 5, 6, 7, 8, 9},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "17:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "17:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "11-rajah-brookes-birdwing",
+			Name:      "Rajah Brooke's Birdwing",
+			Icon:      "/static/images/bugs/icons/rajah-brookes-birdwing.png",
+			SellPrice: 2500,
+			Location:  "Flying",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 4, 5, 6, 7, 8, 9, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "17:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 6, 7, 8, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "17:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "12-queen-alexandras-birdwing",
+			Name:      "Queen Alexandra's Birdwing",
+			Icon:      "/static/images/bugs/icons/queen-alexandras-birdwing.png",
+			SellPrice: 4000,
+			Location:  "Flying",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{5, 6, 7, 8, 9},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "16:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "16:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "13-moth",
+			Name:      "Moth",
+			Icon:      "/static/images/bugs/icons/moth.png",
+			SellPrice: 130,
+			Location:  "Flying near light sources",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "19:00", End: "04:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "19:00", End: "04:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "14-atlas-moth",
+			Name:      "Atlas Moth",
+			Icon:      "/static/images/bugs/icons/atlas-moth.png",
+			SellPrice: 3000,
+			Location:  "On trees",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{4, 5, 6, 7, 8, 9},
+					TimeRanges: []models.TimeRange{
+						{Start: "19:00", End: "04:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "19:00", End: "04:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "15-madagascan-sunset-moth",
+			Name:      "Madagascan Sunset Moth",
+			Icon:      "/static/images/bugs/icons/madagascan-sunset-moth.png",
+			SellPrice: 2500,
+			Location:  "Flying",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{4, 5, 6, 7, 8, 9},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "16:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "16:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "16-long-locust",
+			Name:      "Long Locust",
+			Icon:      "/static/images/bugs/icons/long-locust.png",
+			SellPrice: 200,
+			Location:  "On the ground",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{4, 5, 6, 7, 8, 9, 10, 11},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "19:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "19:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "17-migratory-locust",
+			Name:      "Migratory Locust",
+			Icon:      "/static/images/bugs/icons/migratory-locust.png",
+			SellPrice: 600,
+			Location:  "On the ground",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{8, 9, 10, 11},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "19:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{2, 3, 4, 5},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "19:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "18-rice-grasshopper",
+			Name:      "Rice Grasshopper",
+			Icon:      "/static/images/bugs/icons/rice-grasshopper.png",
+			SellPrice: 160,
+			Location:  "On the ground",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{8, 9, 10, 11},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "19:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{2, 3, 4, 5},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "19:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "19-grasshopper",
+			Name:      "Grasshopper",
+			Icon:      "/static/images/bugs/icons/grasshopper.png",
+			SellPrice: 160,
+			Location:  "On the ground",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{7, 8, 9},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "17:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "17:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "20-cricket",
+			Name:      "Cricket",
+			Icon:      "/static/images/bugs/icons/cricket.png",
+			SellPrice: 130,
+			Location:  "On the ground",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{9, 10, 11},
+					TimeRanges: []models.TimeRange{
+						{Start: "17:00", End: "08:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4, 5},
+					TimeRanges: []models.TimeRange{
+						{Start: "17:00", End: "08:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "21-bell-cricket",
+			Name:      "Bell Cricket",
+			Icon:      "/static/images/bugs/icons/bell-cricket.png",
+			SellPrice: 430,
+			Location:  "On the ground",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{9, 10},
+					TimeRanges: []models.TimeRange{
+						{Start: "17:00", End: "08:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4},
+					TimeRanges: []models.TimeRange{
+						{Start: "17:00", End: "08:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "22-mantis",
+			Name:      "Mantis",
+			Icon:      "/static/images/bugs/icons/mantis.png",
+			SellPrice: 430,
+			Location:  "On flowers",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4, 5, 6, 7, 8, 9, 10, 11},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "17:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "17:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "23-orchid-mantis",
+			Name:      "Orchid Mantis",
+			Icon:      "/static/images/bugs/icons/orchid-mantis.png",
+			SellPrice: 2400,
+			Location:  "On white flowers",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4, 5, 6, 7, 8, 9, 10, 11},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "17:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "17:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "24-honeybee",
+			Name:      "Honeybee",
+			Icon:      "/static/images/bugs/icons/honeybee.png",
+			SellPrice: 200,
+			Location:  "Flying",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4, 5, 6, 7},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "17:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "17:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "25-wasp",
+			Name:      "Wasp",
+			Icon:      "/static/images/bugs/icons/wasp.png",
+			SellPrice: 2500,
+			Location:  "Shaking trees",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "26-brown-cicada",
+			Name:      "Brown Cicada",
+			Icon:      "/static/images/bugs/icons/brown-cicada.png",
+			SellPrice: 250,
+			Location:  "On trees",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "17:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "17:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "27-robust-cicada",
+			Name:      "Robust Cicada",
+			Icon:      "/static/images/bugs/icons/robust-cicada.png",
+			SellPrice: 300,
+			Location:  "On trees",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "17:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "17:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "28-giant-cicada",
+			Name:      "Giant Cicada",
+			Icon:      "/static/images/bugs/icons/giant-cicada.png",
+			SellPrice: 500,
+			Location:  "On trees",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "17:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "17:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "29-walker-cicada",
+			Name:      "Walker Cicada",
+			Icon:      "/static/images/bugs/icons/walker-cicada.png",
+			SellPrice: 400,
+			Location:  "On trees",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{8, 9},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "17:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{2, 3},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "17:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "30-evening-cicada",
+			Name:      "Evening Cicada",
+			Icon:      "/static/images/bugs/icons/evening-cicada.png",
+			SellPrice: 550,
+			Location:  "On trees",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "04:00", End: "08:00"},
+						{Start: "16:00", End: "19:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2},
+					TimeRanges: []models.TimeRange{
+						{Start: "04:00", End: "08:00"},
+						{Start: "16:00", End: "19:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "31-cicada-shell",
+			Name:      "Cicada Shell",
+			Icon:      "/static/images/bugs/icons/cicada-shell.png",
+			SellPrice: 10,
+			Location:  "On trees",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "32-red-dragonfly",
+			Name:      "Red Dragonfly",
+			Icon:      "/static/images/bugs/icons/red-dragonfly.png",
+			SellPrice: 180,
+			Location:  "Flying",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{9, 10},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "19:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "19:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "33-darner-dragonfly",
+			Name:      "Darner Dragonfly",
+			Icon:      "/static/images/bugs/icons/darner-dragonfly.png",
+			SellPrice: 230,
+			Location:  "Flying",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{4, 5, 6, 7, 8, 9, 10},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "17:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "17:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "34-banded-dragonfly",
+			Name:      "Banded Dragonfly",
+			Icon:      "/static/images/bugs/icons/banded-dragonfly.png",
+			SellPrice: 4500,
+			Location:  "Flying",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{5, 6, 7, 8, 9, 10},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "17:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "17:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "35-damselfly",
+			Name:      "Damselfly",
+			Icon:      "/static/images/bugs/icons/damselfly.png",
+			SellPrice: 500,
+			Location:  "Flying",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{5, 6, 7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "36-firefly",
+			Name:      "Firefly",
+			Icon:      "/static/images/bugs/icons/firefly.png",
+			SellPrice: 300,
+			Location:  "Flying",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{6},
+					TimeRanges: []models.TimeRange{
+						{Start: "19:00", End: "04:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{12},
+					TimeRanges: []models.TimeRange{
+						{Start: "19:00", End: "04:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "37-mole-cricket",
+			Name:      "Mole Cricket",
+			Icon:      "/static/images/bugs/icons/mole-cricket.png",
+			SellPrice: 500,
+			Location:  "Underground",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{5, 6, 7, 8, 9, 10, 11},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "38-pondskater",
+			Name:      "Pondskater",
+			Icon:      "/static/images/bugs/icons/pondskater.png",
+			SellPrice: 130,
+			Location:  "On ponds and rivers",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{5, 6, 7, 8, 9},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "19:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "19:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "39-diving-beetle",
+			Name:      "Diving Beetle",
+			Icon:      "/static/images/bugs/icons/diving-beetle.png",
+			SellPrice: 800,
+			Location:  "On ponds and rivers",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{5, 6, 7, 8, 9},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "19:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "19:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "40-giant-water-bug",
+			Name:      "Giant Water Bug",
+			Icon:      "/static/images/bugs/icons/giant-water-bug.png",
+			SellPrice: 2000,
+			Location:  "On ponds and rivers",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{4, 5, 6, 7, 8, 9},
+					TimeRanges: []models.TimeRange{
+						{Start: "19:00", End: "08:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "19:00", End: "08:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "41-stinkbug",
+			Name:      "Stinkbug",
+			Icon:      "/static/images/bugs/icons/stinkbug.png",
+			SellPrice: 120,
+			Location:  "On flowers",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4, 5, 6, 7, 8, 9, 10},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "42-man-faced-stink-bug",
+			Name:      "Man-faced Stink Bug",
+			Icon:      "/static/images/bugs/icons/man-faced-stink-bug.png",
+			SellPrice: 1000,
+			Location:  "On flowers",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4, 5, 6, 7, 8, 9, 10},
+					TimeRanges: []models.TimeRange{
+						{Start: "19:00", End: "08:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "19:00", End: "08:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "43-ladybug",
+			Name:      "Ladybug",
+			Icon:      "/static/images/bugs/icons/ladybug.png",
+			SellPrice: 200,
+			Location:  "On flowers",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4, 5, 6, 10},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "17:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{4, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "08:00", End: "17:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "44-tiger-beetle",
+			Name:      "Tiger Beetle",
+			Icon:      "/static/images/bugs/icons/tiger-beetle.png",
+			SellPrice: 1500,
+			Location:  "On the ground",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{2, 3, 4, 5, 6, 7, 8, 9, 10},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "45-jewel-beetle",
+			Name:      "Jewel Beetle",
+			Icon:      "/static/images/bugs/icons/jewel-beetle.png",
+			SellPrice: 2400,
+			Location:  "On tree stumps",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{4, 5, 6, 7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "46-violin-beetle",
+			Name:      "Violin Beetle",
+			Icon:      "/static/images/bugs/icons/violin-beetle.png",
+			SellPrice: 450,
+			Location:  "On tree stumps",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{5, 6, 9, 10, 11},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4, 5, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "47-citrus-long-horned-beetle",
+			Name:      "Citrus Long-horned Beetle",
+			Icon:      "/static/images/bugs/icons/citrus-long-horned-beetle.png",
+			SellPrice: 350,
+			Location:  "On tree stumps",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "48-rosalia-batesi-beetle",
+			Name:      "Rosalia Batesi Beetle",
+			Icon:      "/static/images/bugs/icons/rosalia-batesi-beetle.png",
+			SellPrice: 3000,
+			Location:  "On tree stumps",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{5, 6, 7, 8, 9},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "49-blue-weevil-beetle",
+			Name:      "Blue Weevil Beetle",
+			Icon:      "/static/images/bugs/icons/blue-weevil-beetle.png",
+			SellPrice: 800,
+			Location:  "On palm trees",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "50-dung-beetle",
+			Name:      "Dung Beetle",
+			Icon:      "/static/images/bugs/icons/dung-beetle.png",
+			SellPrice: 3000,
+			Location:  "On the ground rolling snowballs",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{6, 7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "51-earth-boring-dung-beetle",
+			Name:      "Earth-boring Dung Beetle",
+			Icon:      "/static/images/bugs/icons/earth-boring-dung-beetle.png",
+			SellPrice: 300,
+			Location:  "On the ground",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{7, 8, 9},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "52-scarab-beetle",
+			Name:      "Scarab Beetle",
+			Icon:      "/static/images/bugs/icons/scarab-beetle.png",
+			SellPrice: 10000,
+			Location:  "On trees",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "23:00", End: "08:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2},
+					TimeRanges: []models.TimeRange{
+						{Start: "23:00", End: "08:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "53-drone-beetle",
+			Name:      "Drone Beetle",
+			Icon:      "/static/images/bugs/icons/drone-beetle.png",
+			SellPrice: 200,
+			Location:  "On trees",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{6, 7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "54-goliath-beetle",
+			Name:      "Goliath Beetle",
+			Icon:      "/static/images/bugs/icons/goliath-beetle.png",
+			SellPrice: 8000,
+			Location:  "On palm trees",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{6, 7, 8, 9},
+					TimeRanges: []models.TimeRange{
+						{Start: "17:00", End: "08:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "17:00", End: "08:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "55-saw-stag",
+			Name:      "Saw Stag",
+			Icon:      "/static/images/bugs/icons/saw-stag.png",
+			SellPrice: 2000,
+			Location:  "On trees",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "56-miyama-stag",
+			Name:      "Miyama Stag",
+			Icon:      "/static/images/bugs/icons/miyama-stag.png",
+			SellPrice: 1000,
+			Location:  "On trees",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "57-giant-stag",
+			Name:      "Giant Stag",
+			Icon:      "/static/images/bugs/icons/giant-stag.png",
+			SellPrice: 10000,
+			Location:  "On trees",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "23:00", End: "08:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2},
+					TimeRanges: []models.TimeRange{
+						{Start: "23:00", End: "08:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "58-rainbow-stag",
+			Name:      "Rainbow Stag",
+			Icon:      "/static/images/bugs/icons/rainbow-stag.png",
+			SellPrice: 6000,
+			Location:  "On trees",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{6, 7, 8, 9},
+					TimeRanges: []models.TimeRange{
+						{Start: "19:00", End: "08:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "19:00", End: "08:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "59-cyclommatus-stag",
+			Name:      "Cyclommatus Stag",
+			Icon:      "/static/images/bugs/icons/cyclommatus-stag.png",
+			SellPrice: 8000,
+			Location:  "On palm trees",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "17:00", End: "08:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2},
+					TimeRanges: []models.TimeRange{
+						{Start: "17:00", End: "08:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "60-golden-stag",
+			Name:      "Golden Stag",
+			Icon:      "/static/images/bugs/icons/golden-stag.png",
+			SellPrice: 12000,
+			Location:  "On palm trees",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "17:00", End: "08:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2},
+					TimeRanges: []models.TimeRange{
+						{Start: "17:00", End: "08:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "61-giraffe-stag",
+			Name:      "Giraffe Stag",
+			Icon:      "/static/images/bugs/icons/giraffe-stag.png",
+			SellPrice: 12000,
+			Location:  "On palm trees",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "17:00", End: "08:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2},
+					TimeRanges: []models.TimeRange{
+						{Start: "17:00", End: "08:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "62-horned-dynastid",
+			Name:      "Horned Dynastid",
+			Icon:      "/static/images/bugs/icons/horned-dynastid.png",
+			SellPrice: 1350,
+			Location:  "On trees",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "17:00", End: "08:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2},
+					TimeRanges: []models.TimeRange{
+						{Start: "17:00", End: "08:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "63-horned-atlas",
+			Name:      "Horned Atlas",
+			Icon:      "/static/images/bugs/icons/horned-atlas.png",
+			SellPrice: 8000,
+			Location:  "On palm trees",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "17:00", End: "08:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2},
+					TimeRanges: []models.TimeRange{
+						{Start: "17:00", End: "08:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "64-horned-elephant",
+			Name:      "Horned Elephant",
+			Icon:      "/static/images/bugs/icons/horned-elephant.png",
+			SellPrice: 8000,
+			Location:  "On palm trees",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "17:00", End: "08:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2},
+					TimeRanges: []models.TimeRange{
+						{Start: "17:00", End: "08:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "65-horned-hercules",
+			Name:      "Horned Hercules",
+			Icon:      "/static/images/bugs/icons/horned-hercules.png",
+			SellPrice: 12000,
+			Location:  "On palm trees",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "17:00", End: "08:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2},
+					TimeRanges: []models.TimeRange{
+						{Start: "17:00", End: "08:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "66-walking-stick",
+			Name:      "Walking Stick",
+			Icon:      "/static/images/bugs/icons/walking-stick.png",
+			SellPrice: 600,
+			Location:  "On trees",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{7, 8, 9, 10, 11},
+					TimeRanges: []models.TimeRange{
+						{Start: "04:00", End: "08:00"},
+						{Start: "17:00", End: "19:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5},
+					TimeRanges: []models.TimeRange{
+						{Start: "04:00", End: "08:00"},
+						{Start: "17:00", End: "19:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "67-walking-leaf",
+			Name:      "Walking Leaf",
+			Icon:      "/static/images/bugs/icons/walking-leaf.png",
+			SellPrice: 600,
+			Location:  "Under trees disguised as leaves",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{7, 8, 9},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "68-bagworm",
+			Name:      "Bagworm",
+			Icon:      "/static/images/bugs/icons/bagworm.png",
+			SellPrice: 600,
+			Location:  "Shaking trees",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "69-ant",
+			Name:      "Ant",
+			Icon:      "/static/images/bugs/icons/ant.png",
+			SellPrice: 80,
+			Location:  "On rotten food",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "70-hermit-crab",
+			Name:      "Hermit Crab",
+			Icon:      "/static/images/bugs/icons/hermit-crab.png",
+			SellPrice: 1000,
+			Location:  "On the beach disguised as shells",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "19:00", End: "08:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "19:00", End: "08:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "71-wharf-roach",
+			Name:      "Wharf Roach",
+			Icon:      "/static/images/bugs/icons/wharf-roach.png",
+			SellPrice: 200,
+			Location:  "On beach rocks",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "72-fly",
+			Name:      "Fly",
+			Icon:      "/static/images/bugs/icons/fly.png",
+			SellPrice: 60,
+			Location:  "On trash items",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "73-mosquito",
+			Name:      "Mosquito",
+			Icon:      "/static/images/bugs/icons/mosquito.png",
+			SellPrice: 130,
+			Location:  "Flying",
+			Weather:   "Any except rain",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{6, 7, 8, 9},
+					TimeRanges: []models.TimeRange{
+						{Start: "17:00", End: "04:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "17:00", End: "04:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "74-flea",
+			Name:      "Flea",
+			Icon:      "/static/images/bugs/icons/flea.png",
+			SellPrice: 70,
+			Location:  "On villagers",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{4, 5, 6, 7, 8, 9, 10, 11},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "75-snail",
+			Name:      "Snail",
+			Icon:      "/static/images/bugs/icons/snail.png",
+			SellPrice: 250,
+			Location:  "On rocks and bushes",
+			Weather:   "Rain only",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "76-pill-bug",
+			Name:      "Pill Bug",
+			Icon:      "/static/images/bugs/icons/pill-bug.png",
+			SellPrice: 250,
+			Location:  "Hitting rocks",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "23:00", End: "16:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "23:00", End: "16:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "77-centipede",
+			Name:      "Centipede",
+			Icon:      "/static/images/bugs/icons/centipede.png",
+			SellPrice: 300,
+			Location:  "Hitting rocks",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "16:00", End: "23:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "16:00", End: "23:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "78-spider",
+			Name:      "Spider",
+			Icon:      "/static/images/bugs/icons/spider.png",
+			SellPrice: 480,
+			Location:  "Shaking trees",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "19:00", End: "08:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "19:00", End: "08:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "79-tarantula",
+			Name:      "Tarantula",
+			Icon:      "/static/images/bugs/icons/tarantula.png",
+			SellPrice: 8000,
+			Location:  "On the ground",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "19:00", End: "04:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{5, 6, 7, 8, 9, 10},
+					TimeRanges: []models.TimeRange{
+						{Start: "19:00", End: "04:00"},
+					},
+				},
+			},
+		},
+		{
+			BugID:     "80-scorpion",
+			Name:      "Scorpion",
+			Icon:      "/static/images/bugs/icons/scorpion.png",
+			SellPrice: 8000,
+			Location:  "On the ground",
+			Weather:   "Any",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{5, 6, 7, 8, 9, 10},
+					TimeRanges: []models.TimeRange{
+						{Start: "19:00", End: "04:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "19:00", End: "04:00"},
+					},
+				},
+			},
+		},
+	}
+}
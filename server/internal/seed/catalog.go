@@ -0,0 +1,31 @@
+package seed
+
+import "github.com/mcgigglepop/acnh-finder/server/internal/models"
+
+// Catalog returns every seeded collectible, fish first, then bugs, sea
+// creatures, fossils, art, the sample recipes and songs
+func Catalog() []models.Collectible {
+	var catalog []models.Collectible
+	for _, f := range Fish() {
+		catalog = append(catalog, f.Collectible())
+	}
+	for _, b := range Bugs() {
+		catalog = append(catalog, b.Collectible())
+	}
+	for _, s := range SeaCreatures() {
+		catalog = append(catalog, s.Collectible())
+	}
+	for _, f := range Fossils() {
+		catalog = append(catalog, f.Collectible())
+	}
+	for _, a := range Art() {
+		catalog = append(catalog, a.Collectible())
+	}
+	for _, r := range SampleRecipes() {
+		catalog = append(catalog, r.Collectible())
+	}
+	for _, s := range Songs() {
+		catalog = append(catalog, s.Collectible())
+	}
+	return catalog
+}
@@ -0,0 +1,580 @@
+package seed
+
+import "github.com/mcgigglepop/acnh-finder/server/internal/models"
+
+// Fossils returns the full fossil catalog, multi-part skeletons first, then
+// standalone fossils
+func Fossils() []models.Fossil {
+	return []models.Fossil{
+		{
+			FossilID:  "1-ankylo-skull",
+			Name:      "Ankylo skull",
+			Icon:      "/static/images/fossils/icons/ankylo-skull.png",
+			SellPrice: 2500,
+			Skeleton:  "Ankylo",
+			Part:      "skull",
+		},
+		{
+			FossilID:  "2-ankylo-torso",
+			Name:      "Ankylo torso",
+			Icon:      "/static/images/fossils/icons/ankylo-torso.png",
+			SellPrice: 3000,
+			Skeleton:  "Ankylo",
+			Part:      "torso",
+		},
+		{
+			FossilID:  "3-ankylo-tail",
+			Name:      "Ankylo tail",
+			Icon:      "/static/images/fossils/icons/ankylo-tail.png",
+			SellPrice: 2500,
+			Skeleton:  "Ankylo",
+			Part:      "tail",
+		},
+		{
+			FossilID:  "4-archelon-skull",
+			Name:      "Archelon skull",
+			Icon:      "/static/images/fossils/icons/archelon-skull.png",
+			SellPrice: 4000,
+			Skeleton:  "Archelon",
+			Part:      "skull",
+		},
+		{
+			FossilID:  "5-archelon-tail",
+			Name:      "Archelon tail",
+			Icon:      "/static/images/fossils/icons/archelon-tail.png",
+			SellPrice: 3500,
+			Skeleton:  "Archelon",
+			Part:      "tail",
+		},
+		{
+			FossilID:  "6-brachio-skull",
+			Name:      "Brachio skull",
+			Icon:      "/static/images/fossils/icons/brachio-skull.png",
+			SellPrice: 5500,
+			Skeleton:  "Brachio",
+			Part:      "skull",
+		},
+		{
+			FossilID:  "7-brachio-chest",
+			Name:      "Brachio chest",
+			Icon:      "/static/images/fossils/icons/brachio-chest.png",
+			SellPrice: 5500,
+			Skeleton:  "Brachio",
+			Part:      "chest",
+		},
+		{
+			FossilID:  "8-brachio-pelvis",
+			Name:      "Brachio pelvis",
+			Icon:      "/static/images/fossils/icons/brachio-pelvis.png",
+			SellPrice: 5000,
+			Skeleton:  "Brachio",
+			Part:      "pelvis",
+		},
+		{
+			FossilID:  "9-brachio-tail",
+			Name:      "Brachio tail",
+			Icon:      "/static/images/fossils/icons/brachio-tail.png",
+			SellPrice: 5500,
+			Skeleton:  "Brachio",
+			Part:      "tail",
+		},
+		{
+			FossilID:  "10-deinony-torso",
+			Name:      "Deinony torso",
+			Icon:      "/static/images/fossils/icons/deinony-torso.png",
+			SellPrice: 3000,
+			Skeleton:  "Deinony",
+			Part:      "torso",
+		},
+		{
+			FossilID:  "11-deinony-tail",
+			Name:      "Deinony tail",
+			Icon:      "/static/images/fossils/icons/deinony-tail.png",
+			SellPrice: 2500,
+			Skeleton:  "Deinony",
+			Part:      "tail",
+		},
+		{
+			FossilID:  "12-dimetrodon-skull",
+			Name:      "Dimetrodon skull",
+			Icon:      "/static/images/fossils/icons/dimetrodon-skull.png",
+			SellPrice: 5500,
+			Skeleton:  "Dimetrodon",
+			Part:      "skull",
+		},
+		{
+			FossilID:  "13-dimetrodon-torso",
+			Name:      "Dimetrodon torso",
+			Icon:      "/static/images/fossils/icons/dimetrodon-torso.png",
+			SellPrice: 5000,
+			Skeleton:  "Dimetrodon",
+			Part:      "torso",
+		},
+		{
+			FossilID:  "14-diplo-skull",
+			Name:      "Diplo skull",
+			Icon:      "/static/images/fossils/icons/diplo-skull.png",
+			SellPrice: 5000,
+			Skeleton:  "Diplo",
+			Part:      "skull",
+		},
+		{
+			FossilID:  "15-diplo-neck",
+			Name:      "Diplo neck",
+			Icon:      "/static/images/fossils/icons/diplo-neck.png",
+			SellPrice: 4500,
+			Skeleton:  "Diplo",
+			Part:      "neck",
+		},
+		{
+			FossilID:  "16-diplo-chest",
+			Name:      "Diplo chest",
+			Icon:      "/static/images/fossils/icons/diplo-chest.png",
+			SellPrice: 4500,
+			Skeleton:  "Diplo",
+			Part:      "chest",
+		},
+		{
+			FossilID:  "17-diplo-pelvis",
+			Name:      "Diplo pelvis",
+			Icon:      "/static/images/fossils/icons/diplo-pelvis.png",
+			SellPrice: 4500,
+			Skeleton:  "Diplo",
+			Part:      "pelvis",
+		},
+		{
+			FossilID:  "18-diplo-tail",
+			Name:      "Diplo tail",
+			Icon:      "/static/images/fossils/icons/diplo-tail.png",
+			SellPrice: 5000,
+			Skeleton:  "Diplo",
+			Part:      "tail",
+		},
+		{
+			FossilID:  "19-diplo-tail-tip",
+			Name:      "Diplo tail tip",
+			Icon:      "/static/images/fossils/icons/diplo-tail-tip.png",
+			SellPrice: 4000,
+			Skeleton:  "Diplo",
+			Part:      "tail tip",
+		},
+		{
+			FossilID:  "20-iguanodon-skull",
+			Name:      "Iguanodon skull",
+			Icon:      "/static/images/fossils/icons/iguanodon-skull.png",
+			SellPrice: 4000,
+			Skeleton:  "Iguanodon",
+			Part:      "skull",
+		},
+		{
+			FossilID:  "21-iguanodon-torso",
+			Name:      "Iguanodon torso",
+			Icon:      "/static/images/fossils/icons/iguanodon-torso.png",
+			SellPrice: 3500,
+			Skeleton:  "Iguanodon",
+			Part:      "torso",
+		},
+		{
+			FossilID:  "22-iguanodon-tail",
+			Name:      "Iguanodon tail",
+			Icon:      "/static/images/fossils/icons/iguanodon-tail.png",
+			SellPrice: 3500,
+			Skeleton:  "Iguanodon",
+			Part:      "tail",
+		},
+		{
+			FossilID:  "23-mammoth-skull",
+			Name:      "Mammoth skull",
+			Icon:      "/static/images/fossils/icons/mammoth-skull.png",
+			SellPrice: 3000,
+			Skeleton:  "Mammoth",
+			Part:      "skull",
+		},
+		{
+			FossilID:  "24-mammoth-torso",
+			Name:      "Mammoth torso",
+			Icon:      "/static/images/fossils/icons/mammoth-torso.png",
+			SellPrice: 2500,
+			Skeleton:  "Mammoth",
+			Part:      "torso",
+		},
+		{
+			FossilID:  "25-megacero-skull",
+			Name:      "Megacero skull",
+			Icon:      "/static/images/fossils/icons/megacero-skull.png",
+			SellPrice: 4000,
+			Skeleton:  "Megacero",
+			Part:      "skull",
+		},
+		{
+			FossilID:  "26-megacero-torso",
+			Name:      "Megacero torso",
+			Icon:      "/static/images/fossils/icons/megacero-torso.png",
+			SellPrice: 3500,
+			Skeleton:  "Megacero",
+			Part:      "torso",
+		},
+		{
+			FossilID:  "27-megacero-tail",
+			Name:      "Megacero tail",
+			Icon:      "/static/images/fossils/icons/megacero-tail.png",
+			SellPrice: 3000,
+			Skeleton:  "Megacero",
+			Part:      "tail",
+		},
+		{
+			FossilID:  "28-left-megalo-side",
+			Name:      "Left megalo side",
+			Icon:      "/static/images/fossils/icons/left-megalo-side.png",
+			SellPrice: 4000,
+			Skeleton:  "Megalo",
+			Part:      "left side",
+		},
+		{
+			FossilID:  "29-right-megalo-side",
+			Name:      "Right megalo side",
+			Icon:      "/static/images/fossils/icons/right-megalo-side.png",
+			SellPrice: 5500,
+			Skeleton:  "Megalo",
+			Part:      "right side",
+		},
+		{
+			FossilID:  "30-ophthalmo-skull",
+			Name:      "Ophthalmo skull",
+			Icon:      "/static/images/fossils/icons/ophthalmo-skull.png",
+			SellPrice: 2500,
+			Skeleton:  "Ophthalmo",
+			Part:      "skull",
+		},
+		{
+			FossilID:  "31-ophthalmo-torso",
+			Name:      "Ophthalmo torso",
+			Icon:      "/static/images/fossils/icons/ophthalmo-torso.png",
+			SellPrice: 2500,
+			Skeleton:  "Ophthalmo",
+			Part:      "torso",
+		},
+		{
+			FossilID:  "32-pachysaurus-skull",
+			Name:      "Pachysaurus skull",
+			Icon:      "/static/images/fossils/icons/pachysaurus-skull.png",
+			SellPrice: 4000,
+			Skeleton:  "Pachysaurus",
+			Part:      "skull",
+		},
+		{
+			FossilID:  "33-pachysaurus-tail",
+			Name:      "Pachysaurus tail",
+			Icon:      "/static/images/fossils/icons/pachysaurus-tail.png",
+			SellPrice: 3500,
+			Skeleton:  "Pachysaurus",
+			Part:      "tail",
+		},
+		{
+			FossilID:  "34-parasaur-skull",
+			Name:      "Parasaur skull",
+			Icon:      "/static/images/fossils/icons/parasaur-skull.png",
+			SellPrice: 3500,
+			Skeleton:  "Parasaur",
+			Part:      "skull",
+		},
+		{
+			FossilID:  "35-parasaur-torso",
+			Name:      "Parasaur torso",
+			Icon:      "/static/images/fossils/icons/parasaur-torso.png",
+			SellPrice: 3000,
+			Skeleton:  "Parasaur",
+			Part:      "torso",
+		},
+		{
+			FossilID:  "36-parasaur-tail",
+			Name:      "Parasaur tail",
+			Icon:      "/static/images/fossils/icons/parasaur-tail.png",
+			SellPrice: 2500,
+			Skeleton:  "Parasaur",
+			Part:      "tail",
+		},
+		{
+			FossilID:  "37-plesio-skull",
+			Name:      "Plesio skull",
+			Icon:      "/static/images/fossils/icons/plesio-skull.png",
+			SellPrice: 4000,
+			Skeleton:  "Plesio",
+			Part:      "skull",
+		},
+		{
+			FossilID:  "38-plesio-body",
+			Name:      "Plesio body",
+			Icon:      "/static/images/fossils/icons/plesio-body.png",
+			SellPrice: 4500,
+			Skeleton:  "Plesio",
+			Part:      "body",
+		},
+		{
+			FossilID:  "39-plesio-tail",
+			Name:      "Plesio tail",
+			Icon:      "/static/images/fossils/icons/plesio-tail.png",
+			SellPrice: 4500,
+			Skeleton:  "Plesio",
+			Part:      "tail",
+		},
+		{
+			FossilID:  "40-ptera-body",
+			Name:      "Ptera body",
+			Icon:      "/static/images/fossils/icons/ptera-body.png",
+			SellPrice: 4000,
+			Skeleton:  "Ptera",
+			Part:      "body",
+		},
+		{
+			FossilID:  "41-left-ptera-wing",
+			Name:      "Left ptera wing",
+			Icon:      "/static/images/fossils/icons/left-ptera-wing.png",
+			SellPrice: 4500,
+			Skeleton:  "Ptera",
+			Part:      "left wing",
+		},
+		{
+			FossilID:  "42-right-ptera-wing",
+			Name:      "Right ptera wing",
+			Icon:      "/static/images/fossils/icons/right-ptera-wing.png",
+			SellPrice: 4500,
+			Skeleton:  "Ptera",
+			Part:      "right wing",
+		},
+		{
+			FossilID:  "43-quetzal-torso",
+			Name:      "Quetzal torso",
+			Icon:      "/static/images/fossils/icons/quetzal-torso.png",
+			SellPrice: 4500,
+			Skeleton:  "Quetzal",
+			Part:      "torso",
+		},
+		{
+			FossilID:  "44-left-quetzal-wing",
+			Name:      "Left quetzal wing",
+			Icon:      "/static/images/fossils/icons/left-quetzal-wing.png",
+			SellPrice: 5000,
+			Skeleton:  "Quetzal",
+			Part:      "left wing",
+		},
+		{
+			FossilID:  "45-right-quetzal-wing",
+			Name:      "Right quetzal wing",
+			Icon:      "/static/images/fossils/icons/right-quetzal-wing.png",
+			SellPrice: 5000,
+			Skeleton:  "Quetzal",
+			Part:      "right wing",
+		},
+		{
+			FossilID:  "46-sabertooth-skull",
+			Name:      "Sabertooth skull",
+			Icon:      "/static/images/fossils/icons/sabertooth-skull.png",
+			SellPrice: 2500,
+			Skeleton:  "Sabertooth",
+			Part:      "skull",
+		},
+		{
+			FossilID:  "47-sabertooth-tail",
+			Name:      "Sabertooth tail",
+			Icon:      "/static/images/fossils/icons/sabertooth-tail.png",
+			SellPrice: 2000,
+			Skeleton:  "Sabertooth",
+			Part:      "tail",
+		},
+		{
+			FossilID:  "48-spino-skull",
+			Name:      "Spino skull",
+			Icon:      "/static/images/fossils/icons/spino-skull.png",
+			SellPrice: 4000,
+			Skeleton:  "Spino",
+			Part:      "skull",
+		},
+		{
+			FossilID:  "49-spino-torso",
+			Name:      "Spino torso",
+			Icon:      "/static/images/fossils/icons/spino-torso.png",
+			SellPrice: 3000,
+			Skeleton:  "Spino",
+			Part:      "torso",
+		},
+		{
+			FossilID:  "50-spino-tail",
+			Name:      "Spino tail",
+			Icon:      "/static/images/fossils/icons/spino-tail.png",
+			SellPrice: 2500,
+			Skeleton:  "Spino",
+			Part:      "tail",
+		},
+		{
+			FossilID:  "51-stego-skull",
+			Name:      "Stego skull",
+			Icon:      "/static/images/fossils/icons/stego-skull.png",
+			SellPrice: 5000,
+			Skeleton:  "Stego",
+			Part:      "skull",
+		},
+		{
+			FossilID:  "52-stego-torso",
+			Name:      "Stego torso",
+			Icon:      "/static/images/fossils/icons/stego-torso.png",
+			SellPrice: 4500,
+			Skeleton:  "Stego",
+			Part:      "torso",
+		},
+		{
+			FossilID:  "53-stego-tail",
+			Name:      "Stego tail",
+			Icon:      "/static/images/fossils/icons/stego-tail.png",
+			SellPrice: 4000,
+			Skeleton:  "Stego",
+			Part:      "tail",
+		},
+		{
+			FossilID:  "54-t-rex-skull",
+			Name:      "T. Rex skull",
+			Icon:      "/static/images/fossils/icons/t-rex-skull.png",
+			SellPrice: 6000,
+			Skeleton:  "T. Rex",
+			Part:      "skull",
+		},
+		{
+			FossilID:  "55-t-rex-torso",
+			Name:      "T. Rex torso",
+			Icon:      "/static/images/fossils/icons/t-rex-torso.png",
+			SellPrice: 5500,
+			Skeleton:  "T. Rex",
+			Part:      "torso",
+		},
+		{
+			FossilID:  "56-t-rex-tail",
+			Name:      "T. Rex tail",
+			Icon:      "/static/images/fossils/icons/t-rex-tail.png",
+			SellPrice: 5000,
+			Skeleton:  "T. Rex",
+			Part:      "tail",
+		},
+		{
+			FossilID:  "57-tricera-skull",
+			Name:      "Tricera skull",
+			Icon:      "/static/images/fossils/icons/tricera-skull.png",
+			SellPrice: 5500,
+			Skeleton:  "Tricera",
+			Part:      "skull",
+		},
+		{
+			FossilID:  "58-tricera-torso",
+			Name:      "Tricera torso",
+			Icon:      "/static/images/fossils/icons/tricera-torso.png",
+			SellPrice: 5000,
+			Skeleton:  "Tricera",
+			Part:      "torso",
+		},
+		{
+			FossilID:  "59-tricera-tail",
+			Name:      "Tricera tail",
+			Icon:      "/static/images/fossils/icons/tricera-tail.png",
+			SellPrice: 4500,
+			Skeleton:  "Tricera",
+			Part:      "tail",
+		},
+		{
+			FossilID:  "60-acanthostega",
+			Name:      "Acanthostega",
+			Icon:      "/static/images/fossils/icons/acanthostega.png",
+			SellPrice: 2000,
+			Skeleton:  "Acanthostega",
+		},
+		{
+			FossilID:  "61-amber",
+			Name:      "Amber",
+			Icon:      "/static/images/fossils/icons/amber.png",
+			SellPrice: 1200,
+			Skeleton:  "Amber",
+		},
+		{
+			FossilID:  "62-ammonite",
+			Name:      "Ammonite",
+			Icon:      "/static/images/fossils/icons/ammonite.png",
+			SellPrice: 1100,
+			Skeleton:  "Ammonite",
+		},
+		{
+			FossilID:  "63-anomalocaris",
+			Name:      "Anomalocaris",
+			Icon:      "/static/images/fossils/icons/anomalocaris.png",
+			SellPrice: 2000,
+			Skeleton:  "Anomalocaris",
+		},
+		{
+			FossilID:  "64-archaeopteryx",
+			Name:      "Archaeopteryx",
+			Icon:      "/static/images/fossils/icons/archaeopteryx.png",
+			SellPrice: 1300,
+			Skeleton:  "Archaeopteryx",
+		},
+		{
+			FossilID:  "65-australopith",
+			Name:      "Australopith",
+			Icon:      "/static/images/fossils/icons/australopith.png",
+			SellPrice: 1100,
+			Skeleton:  "Australopith",
+		},
+		{
+			FossilID:  "66-coprolite",
+			Name:      "Coprolite",
+			Icon:      "/static/images/fossils/icons/coprolite.png",
+			SellPrice: 1100,
+			Skeleton:  "Coprolite",
+		},
+		{
+			FossilID:  "67-dinosaur-track",
+			Name:      "Dinosaur track",
+			Icon:      "/static/images/fossils/icons/dinosaur-track.png",
+			SellPrice: 1000,
+			Skeleton:  "Dinosaur track",
+		},
+		{
+			FossilID:  "68-dunkleosteus",
+			Name:      "Dunkleosteus",
+			Icon:      "/static/images/fossils/icons/dunkleosteus.png",
+			SellPrice: 3500,
+			Skeleton:  "Dunkleosteus",
+		},
+		{
+			FossilID:  "69-eusthenopteron",
+			Name:      "Eusthenopteron",
+			Icon:      "/static/images/fossils/icons/eusthenopteron.png",
+			SellPrice: 2000,
+			Skeleton:  "Eusthenopteron",
+		},
+		{
+			FossilID:  "70-juramaia",
+			Name:      "Juramaia",
+			Icon:      "/static/images/fossils/icons/juramaia.png",
+			SellPrice: 1500,
+			Skeleton:  "Juramaia",
+		},
+		{
+			FossilID:  "71-myllokunmingia",
+			Name:      "Myllokunmingia",
+			Icon:      "/static/images/fossils/icons/myllokunmingia.png",
+			SellPrice: 1500,
+			Skeleton:  "Myllokunmingia",
+		},
+		{
+			FossilID:  "72-shark-tooth-pattern",
+			Name:      "Shark-tooth pattern",
+			Icon:      "/static/images/fossils/icons/shark-tooth-pattern.png",
+			SellPrice: 1000,
+			Skeleton:  "Shark-tooth pattern",
+		},
+		{
+			FossilID:  "73-trilobite",
+			Name:      "Trilobite",
+			Icon:      "/static/images/fossils/icons/trilobite.png",
+			SellPrice: 1300,
+			Skeleton:  "Trilobite",
+		},
+	}
+}
@@ -0,0 +1,215 @@
+package seed
+
+import "github.com/mcgigglepop/acnh-finder/server/internal/models"
+
+// SampleRecipes returns a sample of the DIY recipe catalog: the starter
+// tools and a few recipes from early in the game. It is far from the full
+// list, so the app doesn't report recipe completion.
+func SampleRecipes() []models.Recipe {
+	return []models.Recipe{
+		{
+			RecipeID: "1-flimsy-axe",
+			Name:     "Flimsy axe",
+			Icon:     "/static/images/recipes/icons/flimsy-axe.png",
+			Type:     "Tools",
+			Source:   "Tom Nook",
+			Materials: []models.Material{
+				{Name: "Tree branch", Quantity: 5},
+				{Name: "Stone", Quantity: 1},
+			},
+		},
+		{
+			RecipeID: "2-flimsy-fishing-rod",
+			Name:     "Flimsy fishing rod",
+			Icon:     "/static/images/recipes/icons/flimsy-fishing-rod.png",
+			Type:     "Tools",
+			Source:   "Tom Nook",
+			Materials: []models.Material{
+				{Name: "Tree branch", Quantity: 5},
+			},
+		},
+		{
+			RecipeID: "3-flimsy-net",
+			Name:     "Flimsy net",
+			Icon:     "/static/images/recipes/icons/flimsy-net.png",
+			Type:     "Tools",
+			Source:   "Tom Nook",
+			Materials: []models.Material{
+				{Name: "Tree branch", Quantity: 5},
+			},
+		},
+		{
+			RecipeID: "4-flimsy-shovel",
+			Name:     "Flimsy shovel",
+			Icon:     "/static/images/recipes/icons/flimsy-shovel.png",
+			Type:     "Tools",
+			Source:   "Blathers",
+			Materials: []models.Material{
+				{Name: "Hardwood", Quantity: 5},
+			},
+		},
+		{
+			RecipeID: "5-flimsy-watering-can",
+			Name:     "Flimsy watering can",
+			Icon:     "/static/images/recipes/icons/flimsy-watering-can.png",
+			Type:     "Tools",
+			Source:   "Tom Nook",
+			Materials: []models.Material{
+				{Name: "Softwood", Quantity: 5},
+			},
+		},
+		{
+			RecipeID: "6-ladder",
+			Name:     "Ladder",
+			Icon:     "/static/images/recipes/icons/ladder.png",
+			Type:     "Tools",
+			Source:   "Tom Nook",
+			Materials: []models.Material{
+				{Name: "Wood", Quantity: 4},
+				{Name: "Hardwood", Quantity: 4},
+				{Name: "Softwood", Quantity: 4},
+			},
+		},
+		{
+			RecipeID: "7-axe",
+			Name:     "Axe",
+			Icon:     "/static/images/recipes/icons/axe.png",
+			Type:     "Tools",
+			Source:   "Nook Stop",
+			Materials: []models.Material{
+				{Name: "Flimsy axe", Quantity: 1},
+				{Name: "Wood", Quantity: 3},
+				{Name: "Iron nugget", Quantity: 1},
+			},
+		},
+		{
+			RecipeID: "8-fishing-rod",
+			Name:     "Fishing rod",
+			Icon:     "/static/images/recipes/icons/fishing-rod.png",
+			Type:     "Tools",
+			Source:   "Nook Stop",
+			Materials: []models.Material{
+				{Name: "Flimsy fishing rod", Quantity: 1},
+				{Name: "Iron nugget", Quantity: 1},
+			},
+		},
+		{
+			RecipeID: "9-net",
+			Name:     "Net",
+			Icon:     "/static/images/recipes/icons/net.png",
+			Type:     "Tools",
+			Source:   "Nook Stop",
+			Materials: []models.Material{
+				{Name: "Flimsy net", Quantity: 1},
+				{Name: "Iron nugget", Quantity: 1},
+			},
+		},
+		{
+			RecipeID: "10-shovel",
+			Name:     "Shovel",
+			Icon:     "/static/images/recipes/icons/shovel.png",
+			Type:     "Tools",
+			Source:   "Nook Stop",
+			Materials: []models.Material{
+				{Name: "Flimsy shovel", Quantity: 1},
+				{Name: "Iron nugget", Quantity: 1},
+			},
+		},
+		{
+			RecipeID: "11-watering-can",
+			Name:     "Watering can",
+			Icon:     "/static/images/recipes/icons/watering-can.png",
+			Type:     "Tools",
+			Source:   "Nook Stop",
+			Materials: []models.Material{
+				{Name: "Flimsy watering can", Quantity: 1},
+				{Name: "Iron nugget", Quantity: 1},
+			},
+		},
+		{
+			RecipeID: "12-golden-axe",
+			Name:     "Golden axe",
+			Icon:     "/static/images/recipes/icons/golden-axe.png",
+			Type:     "Tools",
+			Source:   "Break 100 axes",
+			Materials: []models.Material{
+				{Name: "Axe", Quantity: 1},
+				{Name: "Gold nugget", Quantity: 1},
+			},
+		},
+		{
+			RecipeID: "13-golden-fishing-rod",
+			Name:     "Golden fishing rod",
+			Icon:     "/static/images/recipes/icons/golden-fishing-rod.png",
+			Type:     "Tools",
+			Source:   "Complete the fish critterpedia",
+			Materials: []models.Material{
+				{Name: "Fishing rod", Quantity: 1},
+				{Name: "Gold nugget", Quantity: 1},
+			},
+		},
+		{
+			RecipeID: "14-golden-net",
+			Name:     "Golden net",
+			Icon:     "/static/images/recipes/icons/golden-net.png",
+			Type:     "Tools",
+			Source:   "Complete the bug critterpedia",
+			Materials: []models.Material{
+				{Name: "Net", Quantity: 1},
+				{Name: "Gold nugget", Quantity: 1},
+			},
+		},
+		{
+			RecipeID: "15-golden-shovel",
+			Name:     "Golden shovel",
+			Icon:     "/static/images/recipes/icons/golden-shovel.png",
+			Type:     "Tools",
+			Source:   "Help Gulliver 30 times",
+			Materials: []models.Material{
+				{Name: "Shovel", Quantity: 1},
+				{Name: "Gold nugget", Quantity: 1},
+			},
+		},
+		{
+			RecipeID: "16-golden-watering-can",
+			Name:     "Golden watering can",
+			Icon:     "/static/images/recipes/icons/golden-watering-can.png",
+			Type:     "Tools",
+			Source:   "Reach a 5-star island rating",
+			Materials: []models.Material{
+				{Name: "Watering can", Quantity: 1},
+				{Name: "Gold nugget", Quantity: 1},
+			},
+		},
+		{
+			RecipeID: "17-campfire",
+			Name:     "Campfire",
+			Icon:     "/static/images/recipes/icons/campfire.png",
+			Type:     "Housewares",
+			Source:   "Tom Nook",
+			Materials: []models.Material{
+				{Name: "Tree branch", Quantity: 3},
+			},
+		},
+		{
+			RecipeID: "18-fish-bait",
+			Name:     "Fish bait",
+			Icon:     "/static/images/recipes/icons/fish-bait.png",
+			Type:     "Miscellaneous",
+			Source:   "Dig up a manila clam",
+			Materials: []models.Material{
+				{Name: "Manila clam", Quantity: 1},
+			},
+		},
+		{
+			RecipeID: "19-medicine",
+			Name:     "Medicine",
+			Icon:     "/static/images/recipes/icons/medicine.png",
+			Type:     "Miscellaneous",
+			Source:   "Villagers",
+			Materials: []models.Material{
+				{Name: "Wasp nest", Quantity: 1},
+			},
+		},
+	}
+}
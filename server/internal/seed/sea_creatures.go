@@ -0,0 +1,1011 @@
+package seed
+
+import "github.com/mcgigglepop/acnh-finder/server/internal/models"
+
+// SeaCreatures returns the full sea creature catalog for both hemispheres
+func SeaCreatures() []models.SeaCreature {
+	return []models.SeaCreature{
+		{
+			SeaCreatureID: "1-seaweed",
+			Name:          "Seaweed",
+			Icon:          "/static/images/sea-creatures/icons/seaweed.png",
+			SellPrice:     600,
+			ShadowSize:    "Large",
+			ShadowIcon:    "/static/images/fish/icons/shadow-large.png",
+			SwimmingSpeed: "Stationary",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "2-sea-grapes",
+			Name:          "Sea Grapes",
+			Icon:          "/static/images/sea-creatures/icons/sea-grapes.png",
+			SellPrice:     900,
+			ShadowSize:    "Small",
+			ShadowIcon:    "/static/images/fish/icons/shadow-small.png",
+			SwimmingSpeed: "Stationary",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{6, 7, 8, 9},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "3-sea-cucumber",
+			Name:          "Sea Cucumber",
+			Icon:          "/static/images/sea-creatures/icons/sea-cucumber.png",
+			SellPrice:     500,
+			ShadowSize:    "Medium",
+			ShadowIcon:    "/static/images/fish/icons/shadow-medium.png",
+			SwimmingSpeed: "Very slow",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{5, 6, 7, 8, 9, 10},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "4-sea-pig",
+			Name:          "Sea Pig",
+			Icon:          "/static/images/sea-creatures/icons/sea-pig.png",
+			SellPrice:     10000,
+			ShadowSize:    "Small",
+			ShadowIcon:    "/static/images/fish/icons/shadow-small.png",
+			SwimmingSpeed: "Fast",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "16:00", End: "09:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{5, 6, 7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "16:00", End: "09:00"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "5-sea-star",
+			Name:          "Sea Star",
+			Icon:          "/static/images/sea-creatures/icons/sea-star.png",
+			SellPrice:     500,
+			ShadowSize:    "Small",
+			ShadowIcon:    "/static/images/fish/icons/shadow-small.png",
+			SwimmingSpeed: "Very slow",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "6-sea-urchin",
+			Name:          "Sea Urchin",
+			Icon:          "/static/images/sea-creatures/icons/sea-urchin.png",
+			SellPrice:     1700,
+			ShadowSize:    "Small",
+			ShadowIcon:    "/static/images/fish/icons/shadow-small.png",
+			SwimmingSpeed: "Slow",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{5, 6, 7, 8, 9},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "7-slate-pencil-urchin",
+			Name:          "Slate Pencil Urchin",
+			Icon:          "/static/images/sea-creatures/icons/slate-pencil-urchin.png",
+			SellPrice:     2000,
+			ShadowSize:    "Medium",
+			ShadowIcon:    "/static/images/fish/icons/shadow-medium.png",
+			SwimmingSpeed: "Slow",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{5, 6, 7, 8, 9},
+					TimeRanges: []models.TimeRange{
+						{Start: "16:00", End: "09:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "16:00", End: "09:00"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "8-sea-anemone",
+			Name:          "Sea Anemone",
+			Icon:          "/static/images/sea-creatures/icons/sea-anemone.png",
+			SellPrice:     500,
+			ShadowSize:    "Large",
+			ShadowIcon:    "/static/images/fish/icons/shadow-large.png",
+			SwimmingSpeed: "Stationary",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "9-moon-jellyfish",
+			Name:          "Moon Jellyfish",
+			Icon:          "/static/images/sea-creatures/icons/moon-jellyfish.png",
+			SellPrice:     600,
+			ShadowSize:    "Small",
+			ShadowIcon:    "/static/images/fish/icons/shadow-small.png",
+			SwimmingSpeed: "Stationary",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{7, 8, 9},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "10-sea-slug",
+			Name:          "Sea Slug",
+			Icon:          "/static/images/sea-creatures/icons/sea-slug.png",
+			SellPrice:     600,
+			ShadowSize:    "Tiny",
+			ShadowIcon:    "/static/images/fish/icons/shadow-tiny.png",
+			SwimmingSpeed: "Very slow",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "11-pearl-oyster",
+			Name:          "Pearl Oyster",
+			Icon:          "/static/images/sea-creatures/icons/pearl-oyster.png",
+			SellPrice:     2800,
+			ShadowSize:    "Small",
+			ShadowIcon:    "/static/images/fish/icons/shadow-small.png",
+			SwimmingSpeed: "Very slow",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "12-mussel",
+			Name:          "Mussel",
+			Icon:          "/static/images/sea-creatures/icons/mussel.png",
+			SellPrice:     1500,
+			ShadowSize:    "Small",
+			ShadowIcon:    "/static/images/fish/icons/shadow-small.png",
+			SwimmingSpeed: "Very slow",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "13-oyster",
+			Name:          "Oyster",
+			Icon:          "/static/images/sea-creatures/icons/oyster.png",
+			SellPrice:     1100,
+			ShadowSize:    "Small",
+			ShadowIcon:    "/static/images/fish/icons/shadow-small.png",
+			SwimmingSpeed: "Very slow",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4, 5, 6, 7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "14-scallop",
+			Name:          "Scallop",
+			Icon:          "/static/images/sea-creatures/icons/scallop.png",
+			SellPrice:     1200,
+			ShadowSize:    "Medium",
+			ShadowIcon:    "/static/images/fish/icons/shadow-medium.png",
+			SwimmingSpeed: "Very slow",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "15-whelk",
+			Name:          "Whelk",
+			Icon:          "/static/images/sea-creatures/icons/whelk.png",
+			SellPrice:     1000,
+			ShadowSize:    "Small",
+			ShadowIcon:    "/static/images/fish/icons/shadow-small.png",
+			SwimmingSpeed: "Very slow",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "16-turban-shell",
+			Name:          "Turban Shell",
+			Icon:          "/static/images/sea-creatures/icons/turban-shell.png",
+			SellPrice:     1000,
+			ShadowSize:    "Small",
+			ShadowIcon:    "/static/images/fish/icons/shadow-small.png",
+			SwimmingSpeed: "Very slow",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4, 5, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4, 5, 6, 9, 10, 11},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "17-abalone",
+			Name:          "Abalone",
+			Icon:          "/static/images/sea-creatures/icons/abalone.png",
+			SellPrice:     2000,
+			ShadowSize:    "Medium",
+			ShadowIcon:    "/static/images/fish/icons/shadow-medium.png",
+			SwimmingSpeed: "Medium",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "16:00", End: "09:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "16:00", End: "09:00"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "18-gigas-giant-clam",
+			Name:          "Gigas Giant Clam",
+			Icon:          "/static/images/sea-creatures/icons/gigas-giant-clam.png",
+			SellPrice:     15000,
+			ShadowSize:    "Huge",
+			ShadowIcon:    "/static/images/fish/icons/shadow-huge.png",
+			SwimmingSpeed: "Fast",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{5, 6, 7, 8, 9},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "19-chambered-nautilus",
+			Name:          "Chambered Nautilus",
+			Icon:          "/static/images/sea-creatures/icons/chambered-nautilus.png",
+			SellPrice:     1800,
+			ShadowSize:    "Medium",
+			ShadowIcon:    "/static/images/fish/icons/shadow-medium.png",
+			SwimmingSpeed: "Medium",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4, 5, 6, 9, 10, 11},
+					TimeRanges: []models.TimeRange{
+						{Start: "16:00", End: "09:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4, 5, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "16:00", End: "09:00"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "20-octopus",
+			Name:          "Octopus",
+			Icon:          "/static/images/sea-creatures/icons/octopus.png",
+			SellPrice:     1200,
+			ShadowSize:    "Medium",
+			ShadowIcon:    "/static/images/fish/icons/shadow-medium.png",
+			SwimmingSpeed: "Slow",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "21-umbrella-octopus",
+			Name:          "Umbrella Octopus",
+			Icon:          "/static/images/sea-creatures/icons/umbrella-octopus.png",
+			SellPrice:     6000,
+			ShadowSize:    "Small",
+			ShadowIcon:    "/static/images/fish/icons/shadow-small.png",
+			SwimmingSpeed: "Fast",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4, 5, 9, 10, 11},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4, 5, 9, 10, 11},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "22-vampire-squid",
+			Name:          "Vampire Squid",
+			Icon:          "/static/images/sea-creatures/icons/vampire-squid.png",
+			SellPrice:     10000,
+			ShadowSize:    "Medium",
+			ShadowIcon:    "/static/images/fish/icons/shadow-medium.png",
+			SwimmingSpeed: "Fast",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{5, 6, 7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "16:00", End: "09:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "16:00", End: "09:00"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "23-firefly-squid",
+			Name:          "Firefly Squid",
+			Icon:          "/static/images/sea-creatures/icons/firefly-squid.png",
+			SellPrice:     1400,
+			ShadowSize:    "Tiny",
+			ShadowIcon:    "/static/images/fish/icons/shadow-tiny.png",
+			SwimmingSpeed: "Slow",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4, 5, 6},
+					TimeRanges: []models.TimeRange{
+						{Start: "21:00", End: "04:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "21:00", End: "04:00"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "24-gazami-crab",
+			Name:          "Gazami Crab",
+			Icon:          "/static/images/sea-creatures/icons/gazami-crab.png",
+			SellPrice:     2200,
+			ShadowSize:    "Medium",
+			ShadowIcon:    "/static/images/fish/icons/shadow-medium.png",
+			SwimmingSpeed: "Slow",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{6, 7, 8, 9, 10, 11},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "25-dungeness-crab",
+			Name:          "Dungeness Crab",
+			Icon:          "/static/images/sea-creatures/icons/dungeness-crab.png",
+			SellPrice:     1900,
+			ShadowSize:    "Medium",
+			ShadowIcon:    "/static/images/fish/icons/shadow-medium.png",
+			SwimmingSpeed: "Slow",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{5, 6, 7, 8, 9, 10, 11},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "26-snow-crab",
+			Name:          "Snow Crab",
+			Icon:          "/static/images/sea-creatures/icons/snow-crab.png",
+			SellPrice:     6000,
+			ShadowSize:    "Large",
+			ShadowIcon:    "/static/images/fish/icons/shadow-large.png",
+			SwimmingSpeed: "Slow",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{5, 6, 7, 8, 9, 10},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "27-red-king-crab",
+			Name:          "Red King Crab",
+			Icon:          "/static/images/sea-creatures/icons/red-king-crab.png",
+			SellPrice:     8000,
+			ShadowSize:    "Large",
+			ShadowIcon:    "/static/images/fish/icons/shadow-large.png",
+			SwimmingSpeed: "Slow",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{5, 6, 7, 8, 9},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "28-acorn-barnacle",
+			Name:          "Acorn Barnacle",
+			Icon:          "/static/images/sea-creatures/icons/acorn-barnacle.png",
+			SellPrice:     600,
+			ShadowSize:    "Tiny",
+			ShadowIcon:    "/static/images/fish/icons/shadow-tiny.png",
+			SwimmingSpeed: "Stationary",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "29-spider-crab",
+			Name:          "Spider Crab",
+			Icon:          "/static/images/sea-creatures/icons/spider-crab.png",
+			SellPrice:     12000,
+			ShadowSize:    "Huge",
+			ShadowIcon:    "/static/images/fish/icons/shadow-huge.png",
+			SwimmingSpeed: "Medium",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{9, 10},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "30-tiger-prawn",
+			Name:          "Tiger Prawn",
+			Icon:          "/static/images/sea-creatures/icons/tiger-prawn.png",
+			SellPrice:     3000,
+			ShadowSize:    "Small",
+			ShadowIcon:    "/static/images/fish/icons/shadow-small.png",
+			SwimmingSpeed: "Medium",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{6, 7, 8, 9},
+					TimeRanges: []models.TimeRange{
+						{Start: "16:00", End: "09:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "16:00", End: "09:00"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "31-sweet-shrimp",
+			Name:          "Sweet Shrimp",
+			Icon:          "/static/images/sea-creatures/icons/sweet-shrimp.png",
+			SellPrice:     1400,
+			ShadowSize:    "Small",
+			ShadowIcon:    "/static/images/fish/icons/shadow-small.png",
+			SwimmingSpeed: "Medium",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "16:00", End: "09:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{3, 4, 5, 6, 7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "16:00", End: "09:00"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "32-mantis-shrimp",
+			Name:          "Mantis Shrimp",
+			Icon:          "/static/images/sea-creatures/icons/mantis-shrimp.png",
+			SellPrice:     2500,
+			ShadowSize:    "Small",
+			ShadowIcon:    "/static/images/fish/icons/shadow-small.png",
+			SwimmingSpeed: "Medium",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "16:00", End: "09:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "16:00", End: "09:00"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "33-spiny-lobster",
+			Name:          "Spiny Lobster",
+			Icon:          "/static/images/sea-creatures/icons/spiny-lobster.png",
+			SellPrice:     5000,
+			ShadowSize:    "Large",
+			ShadowIcon:    "/static/images/fish/icons/shadow-large.png",
+			SwimmingSpeed: "Fast",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "21:00", End: "04:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{4, 5, 6},
+					TimeRanges: []models.TimeRange{
+						{Start: "21:00", End: "04:00"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "34-lobster",
+			Name:          "Lobster",
+			Icon:          "/static/images/sea-creatures/icons/lobster.png",
+			SellPrice:     4500,
+			ShadowSize:    "Large",
+			ShadowIcon:    "/static/images/fish/icons/shadow-large.png",
+			SwimmingSpeed: "Fast",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 4, 5, 6, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{6, 7, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "35-giant-isopod",
+			Name:          "Giant Isopod",
+			Icon:          "/static/images/sea-creatures/icons/giant-isopod.png",
+			SellPrice:     12000,
+			ShadowSize:    "Medium",
+			ShadowIcon:    "/static/images/fish/icons/shadow-medium.png",
+			SwimmingSpeed: "Fast",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{7, 8, 9, 10},
+					TimeRanges: []models.TimeRange{
+						{Start: "09:00", End: "16:00"},
+						{Start: "21:00", End: "04:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4},
+					TimeRanges: []models.TimeRange{
+						{Start: "09:00", End: "16:00"},
+						{Start: "21:00", End: "04:00"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "36-horseshoe-crab",
+			Name:          "Horseshoe Crab",
+			Icon:          "/static/images/sea-creatures/icons/horseshoe-crab.png",
+			SellPrice:     2500,
+			ShadowSize:    "Medium",
+			ShadowIcon:    "/static/images/fish/icons/shadow-medium.png",
+			SwimmingSpeed: "Medium",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{7, 8, 9},
+					TimeRanges: []models.TimeRange{
+						{Start: "21:00", End: "04:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3},
+					TimeRanges: []models.TimeRange{
+						{Start: "21:00", End: "04:00"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "37-sea-pineapple",
+			Name:          "Sea Pineapple",
+			Icon:          "/static/images/sea-creatures/icons/sea-pineapple.png",
+			SellPrice:     1500,
+			ShadowSize:    "Small",
+			ShadowIcon:    "/static/images/fish/icons/shadow-small.png",
+			SwimmingSpeed: "Slow",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{4, 5, 6, 7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "38-spotted-garden-eel",
+			Name:          "Spotted Garden Eel",
+			Icon:          "/static/images/sea-creatures/icons/spotted-garden-eel.png",
+			SellPrice:     1100,
+			ShadowSize:    "Small",
+			ShadowIcon:    "/static/images/fish/icons/shadow-small.png",
+			SwimmingSpeed: "Slow",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{5, 6, 7, 8, 9, 10},
+					TimeRanges: []models.TimeRange{
+						{Start: "04:00", End: "21:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 3, 4, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "04:00", End: "21:00"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "39-flatworm",
+			Name:          "Flatworm",
+			Icon:          "/static/images/sea-creatures/icons/flatworm.png",
+			SellPrice:     700,
+			ShadowSize:    "Tiny",
+			ShadowIcon:    "/static/images/fish/icons/shadow-tiny.png",
+			SwimmingSpeed: "Slow",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{8, 9},
+					TimeRanges: []models.TimeRange{
+						{Start: "16:00", End: "09:00"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{2, 3},
+					TimeRanges: []models.TimeRange{
+						{Start: "16:00", End: "09:00"},
+					},
+				},
+			},
+		},
+		{
+			SeaCreatureID: "40-venus-flower-basket",
+			Name:          "Venus' Flower Basket",
+			Icon:          "/static/images/sea-creatures/icons/venus-flower-basket.png",
+			SellPrice:     5000,
+			ShadowSize:    "Medium",
+			ShadowIcon:    "/static/images/fish/icons/shadow-medium.png",
+			SwimmingSpeed: "Stationary",
+			NorthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{1, 2, 10, 11, 12},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+			SouthAvailability: []models.SeasonalAvailability{
+				{
+					Months: []int{4, 5, 6, 7, 8},
+					TimeRanges: []models.TimeRange{
+						{Start: "00:00", End: "23:59"},
+					},
+				},
+			},
+		},
+	}
+}
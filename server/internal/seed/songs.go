@@ -0,0 +1,626 @@
+package seed
+
+import "github.com/mcgigglepop/acnh-finder/server/internal/models"
+
+// Songs returns the K.K. Slider song catalog
+func Songs() []models.Song {
+	return []models.Song{
+		{
+			SongID:    "1-agent-k-k",
+			Name:      "Agent K.K.",
+			Icon:      "/static/images/songs/icons/agent-k-k.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "2-aloha-k-k",
+			Name:      "Aloha K.K.",
+			Icon:      "/static/images/songs/icons/aloha-k-k.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "3-animal-city",
+			Name:      "Animal City",
+			Icon:      "/static/images/songs/icons/animal-city.png",
+			SellPrice: 800,
+			Secret:    true,
+		},
+		{
+			SongID:    "4-bubblegum-k-k",
+			Name:      "Bubblegum K.K.",
+			Icon:      "/static/images/songs/icons/bubblegum-k-k.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "5-cafe-k-k",
+			Name:      "Café K.K.",
+			Icon:      "/static/images/songs/icons/cafe-k-k.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "6-comrade-k-k",
+			Name:      "Comrade K.K.",
+			Icon:      "/static/images/songs/icons/comrade-k-k.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "7-dj-k-k",
+			Name:      "DJ K.K.",
+			Icon:      "/static/images/songs/icons/dj-k-k.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "8-drivin",
+			Name:      "Drivin'",
+			Icon:      "/static/images/songs/icons/drivin.png",
+			SellPrice: 800,
+			Secret:    true,
+		},
+		{
+			SongID:    "9-farewell",
+			Name:      "Farewell",
+			Icon:      "/static/images/songs/icons/farewell.png",
+			SellPrice: 800,
+			Secret:    true,
+		},
+		{
+			SongID:    "10-forest-life",
+			Name:      "Forest Life",
+			Icon:      "/static/images/songs/icons/forest-life.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "11-go-k-k-rider",
+			Name:      "Go K.K. Rider",
+			Icon:      "/static/images/songs/icons/go-k-k-rider.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "12-hypno-k-k",
+			Name:      "Hypno K.K.",
+			Icon:      "/static/images/songs/icons/hypno-k-k.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "13-i-love-you",
+			Name:      "I Love You",
+			Icon:      "/static/images/songs/icons/i-love-you.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "14-imperial-k-k",
+			Name:      "Imperial K.K.",
+			Icon:      "/static/images/songs/icons/imperial-k-k.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "15-k-k-adventure",
+			Name:      "K.K. Adventure",
+			Icon:      "/static/images/songs/icons/k-k-adventure.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "16-k-k-aria",
+			Name:      "K.K. Aria",
+			Icon:      "/static/images/songs/icons/k-k-aria.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "17-k-k-ballad",
+			Name:      "K.K. Ballad",
+			Icon:      "/static/images/songs/icons/k-k-ballad.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "18-k-k-bazaar",
+			Name:      "K.K. Bazaar",
+			Icon:      "/static/images/songs/icons/k-k-bazaar.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "19-k-k-birthday",
+			Name:      "K.K. Birthday",
+			Icon:      "/static/images/songs/icons/k-k-birthday.png",
+			SellPrice: 800,
+			Secret:    true,
+		},
+		{
+			SongID:    "20-k-k-blues",
+			Name:      "K.K. Blues",
+			Icon:      "/static/images/songs/icons/k-k-blues.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "21-k-k-bossa",
+			Name:      "K.K. Bossa",
+			Icon:      "/static/images/songs/icons/k-k-bossa.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "22-k-k-calypso",
+			Name:      "K.K. Calypso",
+			Icon:      "/static/images/songs/icons/k-k-calypso.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "23-k-k-casbah",
+			Name:      "K.K. Casbah",
+			Icon:      "/static/images/songs/icons/k-k-casbah.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "24-k-k-chorale",
+			Name:      "K.K. Chorale",
+			Icon:      "/static/images/songs/icons/k-k-chorale.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "25-k-k-chorinho",
+			Name:      "K.K. Chorinho",
+			Icon:      "/static/images/songs/icons/k-k-chorinho.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "26-k-k-condor",
+			Name:      "K.K. Condor",
+			Icon:      "/static/images/songs/icons/k-k-condor.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "27-k-k-country",
+			Name:      "K.K. Country",
+			Icon:      "/static/images/songs/icons/k-k-country.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "28-k-k-cruisin",
+			Name:      "K.K. Cruisin'",
+			Icon:      "/static/images/songs/icons/k-k-cruisin.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "29-k-k-dandb",
+			Name:      "K.K. D&B",
+			Icon:      "/static/images/songs/icons/k-k-dandb.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "30-k-k-dirge",
+			Name:      "K.K. Dirge",
+			Icon:      "/static/images/songs/icons/k-k-dirge.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "31-k-k-disco",
+			Name:      "K.K. Disco",
+			Icon:      "/static/images/songs/icons/k-k-disco.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "32-k-k-dixie",
+			Name:      "K.K. Dixie",
+			Icon:      "/static/images/songs/icons/k-k-dixie.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "33-k-k-etude",
+			Name:      "K.K. Étude",
+			Icon:      "/static/images/songs/icons/k-k-etude.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "34-k-k-faire",
+			Name:      "K.K. Faire",
+			Icon:      "/static/images/songs/icons/k-k-faire.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "35-k-k-flamenco",
+			Name:      "K.K. Flamenco",
+			Icon:      "/static/images/songs/icons/k-k-flamenco.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "36-k-k-folk",
+			Name:      "K.K. Folk",
+			Icon:      "/static/images/songs/icons/k-k-folk.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "37-k-k-fugue",
+			Name:      "K.K. Fugue",
+			Icon:      "/static/images/songs/icons/k-k-fugue.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "38-k-k-fusion",
+			Name:      "K.K. Fusion",
+			Icon:      "/static/images/songs/icons/k-k-fusion.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "39-k-k-groove",
+			Name:      "K.K. Groove",
+			Icon:      "/static/images/songs/icons/k-k-groove.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "40-k-k-gumbo",
+			Name:      "K.K. Gumbo",
+			Icon:      "/static/images/songs/icons/k-k-gumbo.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "41-k-k-hop",
+			Name:      "K.K. Hop",
+			Icon:      "/static/images/songs/icons/k-k-hop.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "42-k-k-house",
+			Name:      "K.K. House",
+			Icon:      "/static/images/songs/icons/k-k-house.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "43-k-k-island",
+			Name:      "K.K. Island",
+			Icon:      "/static/images/songs/icons/k-k-island.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "44-k-k-jazz",
+			Name:      "K.K. Jazz",
+			Icon:      "/static/images/songs/icons/k-k-jazz.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "45-k-k-jongara",
+			Name:      "K.K. Jongara",
+			Icon:      "/static/images/songs/icons/k-k-jongara.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "46-k-k-khoomei",
+			Name:      "K.K. Khoomei",
+			Icon:      "/static/images/songs/icons/k-k-khoomei.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "47-k-k-lament",
+			Name:      "K.K. Lament",
+			Icon:      "/static/images/songs/icons/k-k-lament.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "48-k-k-love-song",
+			Name:      "K.K. Love Song",
+			Icon:      "/static/images/songs/icons/k-k-love-song.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "49-k-k-lullaby",
+			Name:      "K.K. Lullaby",
+			Icon:      "/static/images/songs/icons/k-k-lullaby.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "50-k-k-mambo",
+			Name:      "K.K. Mambo",
+			Icon:      "/static/images/songs/icons/k-k-mambo.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "51-k-k-marathon",
+			Name:      "K.K. Marathon",
+			Icon:      "/static/images/songs/icons/k-k-marathon.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "52-k-k-march",
+			Name:      "K.K. March",
+			Icon:      "/static/images/songs/icons/k-k-march.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "53-k-k-mariachi",
+			Name:      "K.K. Mariachi",
+			Icon:      "/static/images/songs/icons/k-k-mariachi.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "54-k-k-metal",
+			Name:      "K.K. Metal",
+			Icon:      "/static/images/songs/icons/k-k-metal.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "55-k-k-milonga",
+			Name:      "K.K. Milonga",
+			Icon:      "/static/images/songs/icons/k-k-milonga.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "56-k-k-moody",
+			Name:      "K.K. Moody",
+			Icon:      "/static/images/songs/icons/k-k-moody.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "57-k-k-oasis",
+			Name:      "K.K. Oasis",
+			Icon:      "/static/images/songs/icons/k-k-oasis.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "58-k-k-parade",
+			Name:      "K.K. Parade",
+			Icon:      "/static/images/songs/icons/k-k-parade.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "59-k-k-polka",
+			Name:      "K.K. Polka",
+			Icon:      "/static/images/songs/icons/k-k-polka.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "60-k-k-ragtime",
+			Name:      "K.K. Ragtime",
+			Icon:      "/static/images/songs/icons/k-k-ragtime.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "61-k-k-rally",
+			Name:      "K.K. Rally",
+			Icon:      "/static/images/songs/icons/k-k-rally.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "62-k-k-reggae",
+			Name:      "K.K. Reggae",
+			Icon:      "/static/images/songs/icons/k-k-reggae.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "63-k-k-robot-synth",
+			Name:      "K.K. Robot Synth",
+			Icon:      "/static/images/songs/icons/k-k-robot-synth.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "64-k-k-rock",
+			Name:      "K.K. Rock",
+			Icon:      "/static/images/songs/icons/k-k-rock.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "65-k-k-rockabilly",
+			Name:      "K.K. Rockabilly",
+			Icon:      "/static/images/songs/icons/k-k-rockabilly.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "66-k-k-safari",
+			Name:      "K.K. Safari",
+			Icon:      "/static/images/songs/icons/k-k-safari.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "67-k-k-salsa",
+			Name:      "K.K. Salsa",
+			Icon:      "/static/images/songs/icons/k-k-salsa.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "68-k-k-samba",
+			Name:      "K.K. Samba",
+			Icon:      "/static/images/songs/icons/k-k-samba.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "69-k-k-ska",
+			Name:      "K.K. Ska",
+			Icon:      "/static/images/songs/icons/k-k-ska.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "70-k-k-slack-key",
+			Name:      "K.K. Slack-Key",
+			Icon:      "/static/images/songs/icons/k-k-slack-key.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "71-k-k-sonata",
+			Name:      "K.K. Sonata",
+			Icon:      "/static/images/songs/icons/k-k-sonata.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "72-k-k-song",
+			Name:      "K.K. Song",
+			Icon:      "/static/images/songs/icons/k-k-song.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "73-k-k-soul",
+			Name:      "K.K. Soul",
+			Icon:      "/static/images/songs/icons/k-k-soul.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "74-k-k-steppe",
+			Name:      "K.K. Steppe",
+			Icon:      "/static/images/songs/icons/k-k-steppe.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "75-k-k-stroll",
+			Name:      "K.K. Stroll",
+			Icon:      "/static/images/songs/icons/k-k-stroll.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "76-k-k-swing",
+			Name:      "K.K. Swing",
+			Icon:      "/static/images/songs/icons/k-k-swing.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "77-k-k-synth",
+			Name:      "K.K. Synth",
+			Icon:      "/static/images/songs/icons/k-k-synth.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "78-k-k-tango",
+			Name:      "K.K. Tango",
+			Icon:      "/static/images/songs/icons/k-k-tango.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "79-k-k-technopop",
+			Name:      "K.K. Technopop",
+			Icon:      "/static/images/songs/icons/k-k-technopop.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "80-k-k-waltz",
+			Name:      "K.K. Waltz",
+			Icon:      "/static/images/songs/icons/k-k-waltz.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "81-k-k-western",
+			Name:      "K.K. Western",
+			Icon:      "/static/images/songs/icons/k-k-western.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "82-king-k-k",
+			Name:      "King K.K.",
+			Icon:      "/static/images/songs/icons/king-k-k.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "83-lucky-k-k",
+			Name:      "Lucky K.K.",
+			Icon:      "/static/images/songs/icons/lucky-k-k.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "84-marine-song-2001",
+			Name:      "Marine Song 2001",
+			Icon:      "/static/images/songs/icons/marine-song-2001.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "85-mountain-song",
+			Name:      "Mountain Song",
+			Icon:      "/static/images/songs/icons/mountain-song.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "86-mr-k-k",
+			Name:      "Mr. K.K.",
+			Icon:      "/static/images/songs/icons/mr-k-k.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "87-my-place",
+			Name:      "My Place",
+			Icon:      "/static/images/songs/icons/my-place.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "88-neapolitan",
+			Name:      "Neapolitan",
+			Icon:      "/static/images/songs/icons/neapolitan.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "89-only-me",
+			Name:      "Only Me",
+			Icon:      "/static/images/songs/icons/only-me.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "90-pondering",
+			Name:      "Pondering",
+			Icon:      "/static/images/songs/icons/pondering.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "91-rockin-k-k",
+			Name:      "Rockin' K.K.",
+			Icon:      "/static/images/songs/icons/rockin-k-k.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "92-soulful-k-k",
+			Name:      "Soulful K.K.",
+			Icon:      "/static/images/songs/icons/soulful-k-k.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "93-space-k-k",
+			Name:      "Space K.K.",
+			Icon:      "/static/images/songs/icons/space-k-k.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "94-spring-blossoms",
+			Name:      "Spring Blossoms",
+			Icon:      "/static/images/songs/icons/spring-blossoms.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "95-stale-cupcakes",
+			Name:      "Stale Cupcakes",
+			Icon:      "/static/images/songs/icons/stale-cupcakes.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "96-steep-hill",
+			Name:      "Steep Hill",
+			Icon:      "/static/images/songs/icons/steep-hill.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "97-surfin-k-k",
+			Name:      "Surfin' K.K.",
+			Icon:      "/static/images/songs/icons/surfin-k-k.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "98-the-k-funk",
+			Name:      "The K. Funk",
+			Icon:      "/static/images/songs/icons/the-k-funk.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "99-to-the-edge",
+			Name:      "To the Edge",
+			Icon:      "/static/images/songs/icons/to-the-edge.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "100-two-days-ago",
+			Name:      "Two Days Ago",
+			Icon:      "/static/images/songs/icons/two-days-ago.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "101-wandering",
+			Name:      "Wandering",
+			Icon:      "/static/images/songs/icons/wandering.png",
+			SellPrice: 800,
+		},
+		{
+			SongID:    "102-welcome-horizons",
+			Name:      "Welcome Horizons",
+			Icon:      "/static/images/songs/icons/welcome-horizons.png",
+			SellPrice: 800,
+			Secret:    true,
+		},
+	}
+}
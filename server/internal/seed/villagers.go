@@ -0,0 +1,4553 @@
+package seed
+
+import "github.com/mcgigglepop/acnh-finder/server/internal/models"
+
+// Villagers returns the villager directory, in alphabetical order. IDs are
+// the slug of the villager's name, so they stay put as villagers are added.
+func Villagers() []models.Villager {
+	return []models.Villager{
+		{
+			VillagerID:  "ace",
+			Name:        "Ace",
+			Icon:        "/static/images/villagers/icons/ace.png",
+			Species:     "Bird",
+			Personality: "Jock",
+			BirthMonth:  10,
+			BirthDay:    2,
+			Hobby:       "Fitness",
+			Catchphrase: "ace",
+		},
+		{
+			VillagerID:  "admiral",
+			Name:        "Admiral",
+			Icon:        "/static/images/villagers/icons/admiral.png",
+			Species:     "Bird",
+			Personality: "Cranky",
+			BirthMonth:  1,
+			BirthDay:    27,
+			Hobby:       "Nature",
+			Catchphrase: "aye aye",
+		},
+		{
+			VillagerID:  "agent-s",
+			Name:        "Agent S",
+			Icon:        "/static/images/villagers/icons/agent-s.png",
+			Species:     "Squirrel",
+			Personality: "Peppy",
+			BirthMonth:  7,
+			BirthDay:    2,
+			Hobby:       "Fitness",
+			Catchphrase: "sidekick",
+		},
+		{
+			VillagerID:  "agnes",
+			Name:        "Agnes",
+			Icon:        "/static/images/villagers/icons/agnes.png",
+			Species:     "Pig",
+			Personality: "Sisterly",
+			BirthMonth:  4,
+			BirthDay:    21,
+			Hobby:       "Play",
+			Catchphrase: "snuffle",
+		},
+		{
+			VillagerID:  "al",
+			Name:        "Al",
+			Icon:        "/static/images/villagers/icons/al.png",
+			Species:     "Gorilla",
+			Personality: "Lazy",
+			BirthMonth:  10,
+			BirthDay:    18,
+			Hobby:       "Fitness",
+			Catchphrase: "ayyyeee",
+		},
+		{
+			VillagerID:  "alfonso",
+			Name:        "Alfonso",
+			Icon:        "/static/images/villagers/icons/alfonso.png",
+			Species:     "Alligator",
+			Personality: "Lazy",
+			BirthMonth:  6,
+			BirthDay:    9,
+			Hobby:       "Play",
+			Catchphrase: "it'sa me",
+		},
+		{
+			VillagerID:  "alice",
+			Name:        "Alice",
+			Icon:        "/static/images/villagers/icons/alice.png",
+			Species:     "Koala",
+			Personality: "Normal",
+			BirthMonth:  8,
+			BirthDay:    19,
+			Hobby:       "Education",
+			Catchphrase: "guvnor",
+		},
+		{
+			VillagerID:  "alli",
+			Name:        "Alli",
+			Icon:        "/static/images/villagers/icons/alli.png",
+			Species:     "Alligator",
+			Personality: "Snooty",
+			BirthMonth:  11,
+			BirthDay:    8,
+			Hobby:       "Fashion",
+			Catchphrase: "graaagh",
+		},
+		{
+			VillagerID:  "amelia",
+			Name:        "Amelia",
+			Icon:        "/static/images/villagers/icons/amelia.png",
+			Species:     "Eagle",
+			Personality: "Snooty",
+			BirthMonth:  11,
+			BirthDay:    19,
+			Hobby:       "Fashion",
+			Catchphrase: "cuz",
+		},
+		{
+			VillagerID:  "anabelle",
+			Name:        "Anabelle",
+			Icon:        "/static/images/villagers/icons/anabelle.png",
+			Species:     "Anteater",
+			Personality: "Peppy",
+			BirthMonth:  2,
+			BirthDay:    16,
+			Hobby:       "Fashion",
+			Catchphrase: "snorty",
+		},
+		{
+			VillagerID:  "anchovy",
+			Name:        "Anchovy",
+			Icon:        "/static/images/villagers/icons/anchovy.png",
+			Species:     "Bird",
+			Personality: "Lazy",
+			BirthMonth:  3,
+			BirthDay:    4,
+			Hobby:       "Nature",
+			Catchphrase: "chuurp",
+		},
+		{
+			VillagerID:  "angus",
+			Name:        "Angus",
+			Icon:        "/static/images/villagers/icons/angus.png",
+			Species:     "Bull",
+			Personality: "Cranky",
+			BirthMonth:  4,
+			BirthDay:    30,
+			Hobby:       "Nature",
+			Catchphrase: "macmoo",
+		},
+		{
+			VillagerID:  "anicotti",
+			Name:        "Anicotti",
+			Icon:        "/static/images/villagers/icons/anicotti.png",
+			Species:     "Mouse",
+			Personality: "Peppy",
+			BirthMonth:  2,
+			BirthDay:    24,
+			Hobby:       "Fashion",
+			Catchphrase: "cannoli",
+		},
+		{
+			VillagerID:  "ankha",
+			Name:        "Ankha",
+			Icon:        "/static/images/villagers/icons/ankha.png",
+			Species:     "Cat",
+			Personality: "Snooty",
+			BirthMonth:  9,
+			BirthDay:    22,
+			Hobby:       "Music",
+			Catchphrase: "me meow",
+		},
+		{
+			VillagerID:  "annalisa",
+			Name:        "Annalisa",
+			Icon:        "/static/images/villagers/icons/annalisa.png",
+			Species:     "Anteater",
+			Personality: "Normal",
+			BirthMonth:  2,
+			BirthDay:    6,
+			Hobby:       "Education",
+			Catchphrase: "gumdrop",
+		},
+		{
+			VillagerID:  "annalise",
+			Name:        "Annalise",
+			Icon:        "/static/images/villagers/icons/annalise.png",
+			Species:     "Horse",
+			Personality: "Snooty",
+			BirthMonth:  12,
+			BirthDay:    2,
+			Hobby:       "Fashion",
+			Catchphrase: "nipper",
+		},
+		{
+			VillagerID:  "antonio",
+			Name:        "Antonio",
+			Icon:        "/static/images/villagers/icons/antonio.png",
+			Species:     "Anteater",
+			Personality: "Jock",
+			BirthMonth:  10,
+			BirthDay:    20,
+			Hobby:       "Fitness",
+			Catchphrase: "honk",
+		},
+		{
+			VillagerID:  "apollo",
+			Name:        "Apollo",
+			Icon:        "/static/images/villagers/icons/apollo.png",
+			Species:     "Eagle",
+			Personality: "Cranky",
+			BirthMonth:  7,
+			BirthDay:    4,
+			Hobby:       "Music",
+			Catchphrase: "pah",
+		},
+		{
+			VillagerID:  "apple",
+			Name:        "Apple",
+			Icon:        "/static/images/villagers/icons/apple.png",
+			Species:     "Hamster",
+			Personality: "Peppy",
+			BirthMonth:  9,
+			BirthDay:    24,
+			Hobby:       "Music",
+			Catchphrase: "cheekers",
+		},
+		{
+			VillagerID:  "astrid",
+			Name:        "Astrid",
+			Icon:        "/static/images/villagers/icons/astrid.png",
+			Species:     "Kangaroo",
+			Personality: "Snooty",
+			BirthMonth:  9,
+			BirthDay:    8,
+			Hobby:       "Fashion",
+			Catchphrase: "my pet",
+		},
+		{
+			VillagerID:  "audie",
+			Name:        "Audie",
+			Icon:        "/static/images/villagers/icons/audie.png",
+			Species:     "Wolf",
+			Personality: "Peppy",
+			BirthMonth:  8,
+			BirthDay:    31,
+			Hobby:       "Fitness",
+			Catchphrase: "foxtrot",
+		},
+		{
+			VillagerID:  "aurora",
+			Name:        "Aurora",
+			Icon:        "/static/images/villagers/icons/aurora.png",
+			Species:     "Penguin",
+			Personality: "Normal",
+			BirthMonth:  1,
+			BirthDay:    27,
+			Hobby:       "Nature",
+			Catchphrase: "b-b-baby",
+		},
+		{
+			VillagerID:  "ava",
+			Name:        "Ava",
+			Icon:        "/static/images/villagers/icons/ava.png",
+			Species:     "Chicken",
+			Personality: "Normal",
+			BirthMonth:  4,
+			BirthDay:    28,
+			Hobby:       "Education",
+			Catchphrase: "beaker",
+		},
+		{
+			VillagerID:  "avery",
+			Name:        "Avery",
+			Icon:        "/static/images/villagers/icons/avery.png",
+			Species:     "Eagle",
+			Personality: "Cranky",
+			BirthMonth:  2,
+			BirthDay:    22,
+			Hobby:       "Education",
+			Catchphrase: "skree-haw",
+		},
+		{
+			VillagerID:  "axel",
+			Name:        "Axel",
+			Icon:        "/static/images/villagers/icons/axel.png",
+			Species:     "Elephant",
+			Personality: "Jock",
+			BirthMonth:  3,
+			BirthDay:    23,
+			Hobby:       "Fitness",
+			Catchphrase: "WHONK",
+		},
+		{
+			VillagerID:  "azalea",
+			Name:        "Azalea",
+			Icon:        "/static/images/villagers/icons/azalea.png",
+			Species:     "Rhino",
+			Personality: "Snooty",
+			BirthMonth:  8,
+			BirthDay:    18,
+			Hobby:       "Nature",
+			Catchphrase: "bloomin'",
+		},
+		{
+			VillagerID:  "baabara",
+			Name:        "Baabara",
+			Icon:        "/static/images/villagers/icons/baabara.png",
+			Species:     "Sheep",
+			Personality: "Snooty",
+			BirthMonth:  3,
+			BirthDay:    28,
+			Hobby:       "Fashion",
+			Catchphrase: "daahling",
+		},
+		{
+			VillagerID:  "bam",
+			Name:        "Bam",
+			Icon:        "/static/images/villagers/icons/bam.png",
+			Species:     "Deer",
+			Personality: "Jock",
+			BirthMonth:  11,
+			BirthDay:    7,
+			Hobby:       "Fitness",
+			Catchphrase: "kablang",
+		},
+		{
+			VillagerID:  "bangle",
+			Name:        "Bangle",
+			Icon:        "/static/images/villagers/icons/bangle.png",
+			Species:     "Tiger",
+			Personality: "Peppy",
+			BirthMonth:  8,
+			BirthDay:    27,
+			Hobby:       "Fashion",
+			Catchphrase: "growf",
+		},
+		{
+			VillagerID:  "barold",
+			Name:        "Barold",
+			Icon:        "/static/images/villagers/icons/barold.png",
+			Species:     "Cub",
+			Personality: "Lazy",
+			BirthMonth:  3,
+			BirthDay:    2,
+			Hobby:       "Play",
+			Catchphrase: "cubby",
+		},
+		{
+			VillagerID:  "bea",
+			Name:        "Bea",
+			Icon:        "/static/images/villagers/icons/bea.png",
+			Species:     "Dog",
+			Personality: "Normal",
+			BirthMonth:  10,
+			BirthDay:    15,
+			Hobby:       "Nature",
+			Catchphrase: "bingo",
+		},
+		{
+			VillagerID:  "beardo",
+			Name:        "Beardo",
+			Icon:        "/static/images/villagers/icons/beardo.png",
+			Species:     "Bear",
+			Personality: "Smug",
+			BirthMonth:  9,
+			BirthDay:    27,
+			Hobby:       "Music",
+			Catchphrase: "whiskers",
+		},
+		{
+			VillagerID:  "beau",
+			Name:        "Beau",
+			Icon:        "/static/images/villagers/icons/beau.png",
+			Species:     "Deer",
+			Personality: "Lazy",
+			BirthMonth:  4,
+			BirthDay:    5,
+			Hobby:       "Nature",
+			Catchphrase: "saltlick",
+		},
+		{
+			VillagerID:  "becky",
+			Name:        "Becky",
+			Icon:        "/static/images/villagers/icons/becky.png",
+			Species:     "Chicken",
+			Personality: "Snooty",
+			BirthMonth:  12,
+			BirthDay:    9,
+			Hobby:       "Fashion",
+			Catchphrase: "chicklet",
+		},
+		{
+			VillagerID:  "bella",
+			Name:        "Bella",
+			Icon:        "/static/images/villagers/icons/bella.png",
+			Species:     "Mouse",
+			Personality: "Peppy",
+			BirthMonth:  12,
+			BirthDay:    28,
+			Hobby:       "Music",
+			Catchphrase: "eeks",
+		},
+		{
+			VillagerID:  "benedict",
+			Name:        "Benedict",
+			Icon:        "/static/images/villagers/icons/benedict.png",
+			Species:     "Chicken",
+			Personality: "Lazy",
+			BirthMonth:  10,
+			BirthDay:    10,
+			Hobby:       "Nature",
+			Catchphrase: "uh-hoo",
+		},
+		{
+			VillagerID:  "benjamin",
+			Name:        "Benjamin",
+			Icon:        "/static/images/villagers/icons/benjamin.png",
+			Species:     "Dog",
+			Personality: "Lazy",
+			BirthMonth:  8,
+			BirthDay:    3,
+			Hobby:       "Play",
+			Catchphrase: "alrighty",
+		},
+		{
+			VillagerID:  "bertha",
+			Name:        "Bertha",
+			Icon:        "/static/images/villagers/icons/bertha.png",
+			Species:     "Hippo",
+			Personality: "Normal",
+			BirthMonth:  4,
+			BirthDay:    25,
+			Hobby:       "Education",
+			Catchphrase: "bloop",
+		},
+		{
+			VillagerID:  "bettina",
+			Name:        "Bettina",
+			Icon:        "/static/images/villagers/icons/bettina.png",
+			Species:     "Mouse",
+			Personality: "Normal",
+			BirthMonth:  6,
+			BirthDay:    12,
+			Hobby:       "Education",
+			Catchphrase: "eekers",
+		},
+		{
+			VillagerID:  "bianca",
+			Name:        "Bianca",
+			Icon:        "/static/images/villagers/icons/bianca.png",
+			Species:     "Tiger",
+			Personality: "Peppy",
+			BirthMonth:  12,
+			BirthDay:    13,
+			Hobby:       "Fashion",
+			Catchphrase: "glimmer",
+		},
+		{
+			VillagerID:  "biff",
+			Name:        "Biff",
+			Icon:        "/static/images/villagers/icons/biff.png",
+			Species:     "Hippo",
+			Personality: "Jock",
+			BirthMonth:  3,
+			BirthDay:    29,
+			Hobby:       "Fitness",
+			Catchphrase: "squirt",
+		},
+		{
+			VillagerID:  "big-top",
+			Name:        "Big Top",
+			Icon:        "/static/images/villagers/icons/big-top.png",
+			Species:     "Elephant",
+			Personality: "Lazy",
+			BirthMonth:  10,
+			BirthDay:    3,
+			Hobby:       "Play",
+			Catchphrase: "villain",
+		},
+		{
+			VillagerID:  "bill",
+			Name:        "Bill",
+			Icon:        "/static/images/villagers/icons/bill.png",
+			Species:     "Duck",
+			Personality: "Jock",
+			BirthMonth:  2,
+			BirthDay:    1,
+			Hobby:       "Fitness",
+			Catchphrase: "quacko",
+		},
+		{
+			VillagerID:  "billy",
+			Name:        "Billy",
+			Icon:        "/static/images/villagers/icons/billy.png",
+			Species:     "Goat",
+			Personality: "Jock",
+			BirthMonth:  3,
+			BirthDay:    25,
+			Hobby:       "Fitness",
+			Catchphrase: "dagnaabit",
+		},
+		{
+			VillagerID:  "biskit",
+			Name:        "Biskit",
+			Icon:        "/static/images/villagers/icons/biskit.png",
+			Species:     "Dog",
+			Personality: "Lazy",
+			BirthMonth:  5,
+			BirthDay:    13,
+			Hobby:       "Play",
+			Catchphrase: "dawg",
+		},
+		{
+			VillagerID:  "bitty",
+			Name:        "Bitty",
+			Icon:        "/static/images/villagers/icons/bitty.png",
+			Species:     "Hippo",
+			Personality: "Snooty",
+			BirthMonth:  10,
+			BirthDay:    6,
+			Hobby:       "Fashion",
+			Catchphrase: "my dear",
+		},
+		{
+			VillagerID:  "blaire",
+			Name:        "Blaire",
+			Icon:        "/static/images/villagers/icons/blaire.png",
+			Species:     "Squirrel",
+			Personality: "Snooty",
+			BirthMonth:  7,
+			BirthDay:    3,
+			Hobby:       "Fashion",
+			Catchphrase: "nutlet",
+		},
+		{
+			VillagerID:  "blanche",
+			Name:        "Blanche",
+			Icon:        "/static/images/villagers/icons/blanche.png",
+			Species:     "Ostrich",
+			Personality: "Snooty",
+			BirthMonth:  12,
+			BirthDay:    21,
+			Hobby:       "Fashion",
+			Catchphrase: "quite so",
+		},
+		{
+			VillagerID:  "bluebear",
+			Name:        "Bluebear",
+			Icon:        "/static/images/villagers/icons/bluebear.png",
+			Species:     "Cub",
+			Personality: "Peppy",
+			BirthMonth:  6,
+			BirthDay:    24,
+			Hobby:       "Fashion",
+			Catchphrase: "peach",
+		},
+		{
+			VillagerID:  "bob",
+			Name:        "Bob",
+			Icon:        "/static/images/villagers/icons/bob.png",
+			Species:     "Cat",
+			Personality: "Lazy",
+			BirthMonth:  1,
+			BirthDay:    1,
+			Hobby:       "Play",
+			Catchphrase: "pthhpth",
+		},
+		{
+			VillagerID:  "bonbon",
+			Name:        "Bonbon",
+			Icon:        "/static/images/villagers/icons/bonbon.png",
+			Species:     "Rabbit",
+			Personality: "Peppy",
+			BirthMonth:  3,
+			BirthDay:    3,
+			Hobby:       "Fashion",
+			Catchphrase: "deelish",
+		},
+		{
+			VillagerID:  "bones",
+			Name:        "Bones",
+			Icon:        "/static/images/villagers/icons/bones.png",
+			Species:     "Dog",
+			Personality: "Lazy",
+			BirthMonth:  8,
+			BirthDay:    4,
+			Hobby:       "Play",
+			Catchphrase: "yip yip",
+		},
+		{
+			VillagerID:  "boomer",
+			Name:        "Boomer",
+			Icon:        "/static/images/villagers/icons/boomer.png",
+			Species:     "Penguin",
+			Personality: "Lazy",
+			BirthMonth:  2,
+			BirthDay:    7,
+			Hobby:       "Nature",
+			Catchphrase: "human",
+		},
+		{
+			VillagerID:  "boone",
+			Name:        "Boone",
+			Icon:        "/static/images/villagers/icons/boone.png",
+			Species:     "Gorilla",
+			Personality: "Jock",
+			BirthMonth:  9,
+			BirthDay:    12,
+			Hobby:       "Fitness",
+			Catchphrase: "baboom",
+		},
+		{
+			VillagerID:  "boots",
+			Name:        "Boots",
+			Icon:        "/static/images/villagers/icons/boots.png",
+			Species:     "Alligator",
+			Personality: "Jock",
+			BirthMonth:  8,
+			BirthDay:    7,
+			Hobby:       "Fitness",
+			Catchphrase: "munchie",
+		},
+		{
+			VillagerID:  "boris",
+			Name:        "Boris",
+			Icon:        "/static/images/villagers/icons/boris.png",
+			Species:     "Pig",
+			Personality: "Cranky",
+			BirthMonth:  11,
+			BirthDay:    6,
+			Hobby:       "Education",
+			Catchphrase: "schnort",
+		},
+		{
+			VillagerID:  "boyd",
+			Name:        "Boyd",
+			Icon:        "/static/images/villagers/icons/boyd.png",
+			Species:     "Gorilla",
+			Personality: "Cranky",
+			BirthMonth:  10,
+			BirthDay:    1,
+			Hobby:       "Music",
+			Catchphrase: "uh-oh",
+		},
+		{
+			VillagerID:  "bree",
+			Name:        "Bree",
+			Icon:        "/static/images/villagers/icons/bree.png",
+			Species:     "Mouse",
+			Personality: "Snooty",
+			BirthMonth:  7,
+			BirthDay:    7,
+			Hobby:       "Fashion",
+			Catchphrase: "cheeseball",
+		},
+		{
+			VillagerID:  "broccolo",
+			Name:        "Broccolo",
+			Icon:        "/static/images/villagers/icons/broccolo.png",
+			Species:     "Mouse",
+			Personality: "Lazy",
+			BirthMonth:  6,
+			BirthDay:    30,
+			Hobby:       "Play",
+			Catchphrase: "eat it",
+		},
+		{
+			VillagerID:  "broffina",
+			Name:        "Broffina",
+			Icon:        "/static/images/villagers/icons/broffina.png",
+			Species:     "Chicken",
+			Personality: "Snooty",
+			BirthMonth:  10,
+			BirthDay:    24,
+			Hobby:       "Fashion",
+			Catchphrase: "cluckadoo",
+		},
+		{
+			VillagerID:  "bruce",
+			Name:        "Bruce",
+			Icon:        "/static/images/villagers/icons/bruce.png",
+			Species:     "Deer",
+			Personality: "Cranky",
+			BirthMonth:  5,
+			BirthDay:    26,
+			Hobby:       "Nature",
+			Catchphrase: "gruff",
+		},
+		{
+			VillagerID:  "bubbles",
+			Name:        "Bubbles",
+			Icon:        "/static/images/villagers/icons/bubbles.png",
+			Species:     "Hippo",
+			Personality: "Peppy",
+			BirthMonth:  9,
+			BirthDay:    18,
+			Hobby:       "Fashion",
+			Catchphrase: "hipster",
+		},
+		{
+			VillagerID:  "buck",
+			Name:        "Buck",
+			Icon:        "/static/images/villagers/icons/buck.png",
+			Species:     "Horse",
+			Personality: "Jock",
+			BirthMonth:  4,
+			BirthDay:    4,
+			Hobby:       "Fitness",
+			Catchphrase: "pardner",
+		},
+		{
+			VillagerID:  "bud",
+			Name:        "Bud",
+			Icon:        "/static/images/villagers/icons/bud.png",
+			Species:     "Lion",
+			Personality: "Jock",
+			BirthMonth:  8,
+			BirthDay:    8,
+			Hobby:       "Fitness",
+			Catchphrase: "dude",
+		},
+		{
+			VillagerID:  "bunnie",
+			Name:        "Bunnie",
+			Icon:        "/static/images/villagers/icons/bunnie.png",
+			Species:     "Rabbit",
+			Personality: "Peppy",
+			BirthMonth:  5,
+			BirthDay:    9,
+			Hobby:       "Fashion",
+			Catchphrase: "tee-hee",
+		},
+		{
+			VillagerID:  "butch",
+			Name:        "Butch",
+			Icon:        "/static/images/villagers/icons/butch.png",
+			Species:     "Dog",
+			Personality: "Cranky",
+			BirthMonth:  11,
+			BirthDay:    1,
+			Hobby:       "Nature",
+			Catchphrase: "ROOOOOWF",
+		},
+		{
+			VillagerID:  "buzz",
+			Name:        "Buzz",
+			Icon:        "/static/images/villagers/icons/buzz.png",
+			Species:     "Eagle",
+			Personality: "Cranky",
+			BirthMonth:  12,
+			BirthDay:    7,
+			Hobby:       "Education",
+			Catchphrase: "captain",
+		},
+		{
+			VillagerID:  "cally",
+			Name:        "Cally",
+			Icon:        "/static/images/villagers/icons/cally.png",
+			Species:     "Squirrel",
+			Personality: "Normal",
+			BirthMonth:  9,
+			BirthDay:    4,
+			Hobby:       "Nature",
+			Catchphrase: "WHEE",
+		},
+		{
+			VillagerID:  "camofrog",
+			Name:        "Camofrog",
+			Icon:        "/static/images/villagers/icons/camofrog.png",
+			Species:     "Frog",
+			Personality: "Cranky",
+			BirthMonth:  6,
+			BirthDay:    5,
+			Hobby:       "Education",
+			Catchphrase: "ten-hut",
+		},
+		{
+			VillagerID:  "canberra",
+			Name:        "Canberra",
+			Icon:        "/static/images/villagers/icons/canberra.png",
+			Species:     "Koala",
+			Personality: "Sisterly",
+			BirthMonth:  5,
+			BirthDay:    14,
+			Hobby:       "Play",
+			Catchphrase: "closekin",
+		},
+		{
+			VillagerID:  "candi",
+			Name:        "Candi",
+			Icon:        "/static/images/villagers/icons/candi.png",
+			Species:     "Mouse",
+			Personality: "Peppy",
+			BirthMonth:  4,
+			BirthDay:    13,
+			Hobby:       "Fashion",
+			Catchphrase: "sweetie",
+		},
+		{
+			VillagerID:  "carmen",
+			Name:        "Carmen",
+			Icon:        "/static/images/villagers/icons/carmen.png",
+			Species:     "Rabbit",
+			Personality: "Peppy",
+			BirthMonth:  1,
+			BirthDay:    6,
+			Hobby:       "Fashion",
+			Catchphrase: "nougat",
+		},
+		{
+			VillagerID:  "caroline",
+			Name:        "Caroline",
+			Icon:        "/static/images/villagers/icons/caroline.png",
+			Species:     "Squirrel",
+			Personality: "Normal",
+			BirthMonth:  7,
+			BirthDay:    15,
+			Hobby:       "Nature",
+			Catchphrase: "hulaaaa",
+		},
+		{
+			VillagerID:  "carrie",
+			Name:        "Carrie",
+			Icon:        "/static/images/villagers/icons/carrie.png",
+			Species:     "Kangaroo",
+			Personality: "Normal",
+			BirthMonth:  12,
+			BirthDay:    5,
+			Hobby:       "Nature",
+			Catchphrase: "little one",
+		},
+		{
+			VillagerID:  "cashmere",
+			Name:        "Cashmere",
+			Icon:        "/static/images/villagers/icons/cashmere.png",
+			Species:     "Sheep",
+			Personality: "Snooty",
+			BirthMonth:  4,
+			BirthDay:    2,
+			Hobby:       "Fashion",
+			Catchphrase: "baaaby",
+		},
+		{
+			VillagerID:  "celia",
+			Name:        "Celia",
+			Icon:        "/static/images/villagers/icons/celia.png",
+			Species:     "Eagle",
+			Personality: "Normal",
+			BirthMonth:  3,
+			BirthDay:    25,
+			Hobby:       "Nature",
+			Catchphrase: "feathers",
+		},
+		{
+			VillagerID:  "cephalobot",
+			Name:        "Cephalobot",
+			Icon:        "/static/images/villagers/icons/cephalobot.png",
+			Species:     "Octopus",
+			Personality: "Smug",
+			BirthMonth:  4,
+			BirthDay:    20,
+			Hobby:       "Education",
+			Catchphrase: "donk",
+		},
+		{
+			VillagerID:  "cesar",
+			Name:        "Cesar",
+			Icon:        "/static/images/villagers/icons/cesar.png",
+			Species:     "Gorilla",
+			Personality: "Cranky",
+			BirthMonth:  9,
+			BirthDay:    6,
+			Hobby:       "Education",
+			Catchphrase: "highness",
+		},
+		{
+			VillagerID:  "chabwick",
+			Name:        "Chabwick",
+			Icon:        "/static/images/villagers/icons/chabwick.png",
+			Species:     "Penguin",
+			Personality: "Lazy",
+			BirthMonth:  10,
+			BirthDay:    29,
+			Hobby:       "Play",
+			Catchphrase: "blargh",
+		},
+		{
+			VillagerID:  "chadder",
+			Name:        "Chadder",
+			Icon:        "/static/images/villagers/icons/chadder.png",
+			Species:     "Mouse",
+			Personality: "Smug",
+			BirthMonth:  12,
+			BirthDay:    15,
+			Hobby:       "Music",
+			Catchphrase: "fromage",
+		},
+		{
+			VillagerID:  "chai",
+			Name:        "Chai",
+			Icon:        "/static/images/villagers/icons/chai.png",
+			Species:     "Elephant",
+			Personality: "Peppy",
+			BirthMonth:  3,
+			BirthDay:    6,
+			Hobby:       "Fashion",
+			Catchphrase: "flap flap",
+		},
+		{
+			VillagerID:  "charlise",
+			Name:        "Charlise",
+			Icon:        "/static/images/villagers/icons/charlise.png",
+			Species:     "Bear",
+			Personality: "Sisterly",
+			BirthMonth:  4,
+			BirthDay:    17,
+			Hobby:       "Fitness",
+			Catchphrase: "urgh",
+		},
+		{
+			VillagerID:  "chelsea",
+			Name:        "Chelsea",
+			Icon:        "/static/images/villagers/icons/chelsea.png",
+			Species:     "Deer",
+			Personality: "Normal",
+			BirthMonth:  1,
+			BirthDay:    18,
+			Hobby:       "Fashion",
+			Catchphrase: "ding-dong",
+		},
+		{
+			VillagerID:  "cheri",
+			Name:        "Cheri",
+			Icon:        "/static/images/villagers/icons/cheri.png",
+			Species:     "Cub",
+			Personality: "Peppy",
+			BirthMonth:  3,
+			BirthDay:    17,
+			Hobby:       "Fashion",
+			Catchphrase: "tralala",
+		},
+		{
+			VillagerID:  "cherry",
+			Name:        "Cherry",
+			Icon:        "/static/images/villagers/icons/cherry.png",
+			Species:     "Dog",
+			Personality: "Sisterly",
+			BirthMonth:  5,
+			BirthDay:    11,
+			Hobby:       "Music",
+			Catchphrase: "what what",
+		},
+		{
+			VillagerID:  "chester",
+			Name:        "Chester",
+			Icon:        "/static/images/villagers/icons/chester.png",
+			Species:     "Cub",
+			Personality: "Lazy",
+			BirthMonth:  8,
+			BirthDay:    6,
+			Hobby:       "Nature",
+			Catchphrase: "rookie",
+		},
+		{
+			VillagerID:  "chevre",
+			Name:        "Chevre",
+			Icon:        "/static/images/villagers/icons/chevre.png",
+			Species:     "Goat",
+			Personality: "Normal",
+			BirthMonth:  3,
+			BirthDay:    6,
+			Hobby:       "Nature",
+			Catchphrase: "la baa",
+		},
+		{
+			VillagerID:  "chief",
+			Name:        "Chief",
+			Icon:        "/static/images/villagers/icons/chief.png",
+			Species:     "Wolf",
+			Personality: "Cranky",
+			BirthMonth:  12,
+			BirthDay:    19,
+			Hobby:       "Nature",
+			Catchphrase: "harrumph",
+		},
+		{
+			VillagerID:  "chops",
+			Name:        "Chops",
+			Icon:        "/static/images/villagers/icons/chops.png",
+			Species:     "Pig",
+			Personality: "Smug",
+			BirthMonth:  10,
+			BirthDay:    13,
+			Hobby:       "Music",
+			Catchphrase: "zoink",
+		},
+		{
+			VillagerID:  "chow",
+			Name:        "Chow",
+			Icon:        "/static/images/villagers/icons/chow.png",
+			Species:     "Bear",
+			Personality: "Cranky",
+			BirthMonth:  7,
+			BirthDay:    22,
+			Hobby:       "Education",
+			Catchphrase: "aiya",
+		},
+		{
+			VillagerID:  "chrissy",
+			Name:        "Chrissy",
+			Icon:        "/static/images/villagers/icons/chrissy.png",
+			Species:     "Rabbit",
+			Personality: "Peppy",
+			BirthMonth:  8,
+			BirthDay:    28,
+			Hobby:       "Fashion",
+			Catchphrase: "sparkles",
+		},
+		{
+			VillagerID:  "claude",
+			Name:        "Claude",
+			Icon:        "/static/images/villagers/icons/claude.png",
+			Species:     "Rabbit",
+			Personality: "Lazy",
+			BirthMonth:  12,
+			BirthDay:    3,
+			Hobby:       "Play",
+			Catchphrase: "hopalong",
+		},
+		{
+			VillagerID:  "claudia",
+			Name:        "Claudia",
+			Icon:        "/static/images/villagers/icons/claudia.png",
+			Species:     "Tiger",
+			Personality: "Snooty",
+			BirthMonth:  11,
+			BirthDay:    22,
+			Hobby:       "Fashion",
+			Catchphrase: "ooh la la",
+		},
+		{
+			VillagerID:  "clay",
+			Name:        "Clay",
+			Icon:        "/static/images/villagers/icons/clay.png",
+			Species:     "Hamster",
+			Personality: "Lazy",
+			BirthMonth:  10,
+			BirthDay:    19,
+			Hobby:       "Play",
+			Catchphrase: "thump",
+		},
+		{
+			VillagerID:  "cleo",
+			Name:        "Cleo",
+			Icon:        "/static/images/villagers/icons/cleo.png",
+			Species:     "Horse",
+			Personality: "Snooty",
+			BirthMonth:  2,
+			BirthDay:    9,
+			Hobby:       "Fashion",
+			Catchphrase: "sugar",
+		},
+		{
+			VillagerID:  "clyde",
+			Name:        "Clyde",
+			Icon:        "/static/images/villagers/icons/clyde.png",
+			Species:     "Horse",
+			Personality: "Lazy",
+			BirthMonth:  5,
+			BirthDay:    1,
+			Hobby:       "Play",
+			Catchphrase: "clip-clawp",
+		},
+		{
+			VillagerID:  "coach",
+			Name:        "Coach",
+			Icon:        "/static/images/villagers/icons/coach.png",
+			Species:     "Bull",
+			Personality: "Jock",
+			BirthMonth:  4,
+			BirthDay:    29,
+			Hobby:       "Fitness",
+			Catchphrase: "stubble",
+		},
+		{
+			VillagerID:  "cobb",
+			Name:        "Cobb",
+			Icon:        "/static/images/villagers/icons/cobb.png",
+			Species:     "Pig",
+			Personality: "Jock",
+			BirthMonth:  10,
+			BirthDay:    7,
+			Hobby:       "Fitness",
+			Catchphrase: "hot dog",
+		},
+		{
+			VillagerID:  "coco",
+			Name:        "Coco",
+			Icon:        "/static/images/villagers/icons/coco.png",
+			Species:     "Rabbit",
+			Personality: "Normal",
+			BirthMonth:  3,
+			BirthDay:    1,
+			Hobby:       "Education",
+			Catchphrase: "doyoing",
+		},
+		{
+			VillagerID:  "cole",
+			Name:        "Cole",
+			Icon:        "/static/images/villagers/icons/cole.png",
+			Species:     "Rabbit",
+			Personality: "Lazy",
+			BirthMonth:  8,
+			BirthDay:    10,
+			Hobby:       "Nature",
+			Catchphrase: "duuude",
+		},
+		{
+			VillagerID:  "colton",
+			Name:        "Colton",
+			Icon:        "/static/images/villagers/icons/colton.png",
+			Species:     "Horse",
+			Personality: "Smug",
+			BirthMonth:  5,
+			BirthDay:    22,
+			Hobby:       "Music",
+			Catchphrase: "check it",
+		},
+		{
+			VillagerID:  "cookie",
+			Name:        "Cookie",
+			Icon:        "/static/images/villagers/icons/cookie.png",
+			Species:     "Dog",
+			Personality: "Peppy",
+			BirthMonth:  6,
+			BirthDay:    18,
+			Hobby:       "Fashion",
+			Catchphrase: "arfer",
+		},
+		{
+			VillagerID:  "cousteau",
+			Name:        "Cousteau",
+			Icon:        "/static/images/villagers/icons/cousteau.png",
+			Species:     "Frog",
+			Personality: "Jock",
+			BirthMonth:  12,
+			BirthDay:    17,
+			Hobby:       "Fitness",
+			Catchphrase: "oui oui",
+		},
+		{
+			VillagerID:  "cranston",
+			Name:        "Cranston",
+			Icon:        "/static/images/villagers/icons/cranston.png",
+			Species:     "Ostrich",
+			Personality: "Smug",
+			BirthMonth:  9,
+			BirthDay:    23,
+			Hobby:       "Education",
+			Catchphrase: "sweatband",
+		},
+		{
+			VillagerID:  "croque",
+			Name:        "Croque",
+			Icon:        "/static/images/villagers/icons/croque.png",
+			Species:     "Frog",
+			Personality: "Cranky",
+			BirthMonth:  7,
+			BirthDay:    18,
+			Hobby:       "Music",
+			Catchphrase: "as if",
+		},
+		{
+			VillagerID:  "cube",
+			Name:        "Cube",
+			Icon:        "/static/images/villagers/icons/cube.png",
+			Species:     "Penguin",
+			Personality: "Lazy",
+			BirthMonth:  1,
+			BirthDay:    29,
+			Hobby:       "Play",
+			Catchphrase: "d-d-dude",
+		},
+		{
+			VillagerID:  "curlos",
+			Name:        "Curlos",
+			Icon:        "/static/images/villagers/icons/curlos.png",
+			Species:     "Sheep",
+			Personality: "Smug",
+			BirthMonth:  5,
+			BirthDay:    8,
+			Hobby:       "Music",
+			Catchphrase: "shearly",
+		},
+		{
+			VillagerID:  "curly",
+			Name:        "Curly",
+			Icon:        "/static/images/villagers/icons/curly.png",
+			Species:     "Pig",
+			Personality: "Jock",
+			BirthMonth:  7,
+			BirthDay:    26,
+			Hobby:       "Fitness",
+			Catchphrase: "nyoink",
+		},
+		{
+			VillagerID:  "curt",
+			Name:        "Curt",
+			Icon:        "/static/images/villagers/icons/curt.png",
+			Species:     "Bear",
+			Personality: "Cranky",
+			BirthMonth:  7,
+			BirthDay:    1,
+			Hobby:       "Nature",
+			Catchphrase: "fuzzball",
+		},
+		{
+			VillagerID:  "cyd",
+			Name:        "Cyd",
+			Icon:        "/static/images/villagers/icons/cyd.png",
+			Species:     "Elephant",
+			Personality: "Cranky",
+			BirthMonth:  6,
+			BirthDay:    9,
+			Hobby:       "Music",
+			Catchphrase: "rockin'",
+		},
+		{
+			VillagerID:  "cyrano",
+			Name:        "Cyrano",
+			Icon:        "/static/images/villagers/icons/cyrano.png",
+			Species:     "Anteater",
+			Personality: "Cranky",
+			BirthMonth:  3,
+			BirthDay:    9,
+			Hobby:       "Education",
+			Catchphrase: "ah-CHOO",
+		},
+		{
+			VillagerID:  "daisy",
+			Name:        "Daisy",
+			Icon:        "/static/images/villagers/icons/daisy.png",
+			Species:     "Dog",
+			Personality: "Normal",
+			BirthMonth:  11,
+			BirthDay:    16,
+			Hobby:       "Nature",
+			Catchphrase: "bow-WOW",
+		},
+		{
+			VillagerID:  "deena",
+			Name:        "Deena",
+			Icon:        "/static/images/villagers/icons/deena.png",
+			Species:     "Duck",
+			Personality: "Normal",
+			BirthMonth:  6,
+			BirthDay:    27,
+			Hobby:       "Nature",
+			Catchphrase: "woohoo",
+		},
+		{
+			VillagerID:  "deirdre",
+			Name:        "Deirdre",
+			Icon:        "/static/images/villagers/icons/deirdre.png",
+			Species:     "Deer",
+			Personality: "Sisterly",
+			BirthMonth:  5,
+			BirthDay:    4,
+			Hobby:       "Play",
+			Catchphrase: "whatevs",
+		},
+		{
+			VillagerID:  "del",
+			Name:        "Del",
+			Icon:        "/static/images/villagers/icons/del.png",
+			Species:     "Alligator",
+			Personality: "Cranky",
+			BirthMonth:  5,
+			BirthDay:    27,
+			Hobby:       "Fitness",
+			Catchphrase: "gronk",
+		},
+		{
+			VillagerID:  "deli",
+			Name:        "Deli",
+			Icon:        "/static/images/villagers/icons/deli.png",
+			Species:     "Monkey",
+			Personality: "Lazy",
+			BirthMonth:  5,
+			BirthDay:    24,
+			Hobby:       "Play",
+			Catchphrase: "monch",
+		},
+		{
+			VillagerID:  "derwin",
+			Name:        "Derwin",
+			Icon:        "/static/images/villagers/icons/derwin.png",
+			Species:     "Duck",
+			Personality: "Lazy",
+			BirthMonth:  5,
+			BirthDay:    25,
+			Hobby:       "Nature",
+			Catchphrase: "derrrrr",
+		},
+		{
+			VillagerID:  "diana",
+			Name:        "Diana",
+			Icon:        "/static/images/villagers/icons/diana.png",
+			Species:     "Deer",
+			Personality: "Snooty",
+			BirthMonth:  1,
+			BirthDay:    4,
+			Hobby:       "Fashion",
+			Catchphrase: "no doy",
+		},
+		{
+			VillagerID:  "diva",
+			Name:        "Diva",
+			Icon:        "/static/images/villagers/icons/diva.png",
+			Species:     "Frog",
+			Personality: "Sisterly",
+			BirthMonth:  10,
+			BirthDay:    2,
+			Hobby:       "Music",
+			Catchphrase: "ya-ha-ha",
+		},
+		{
+			VillagerID:  "dizzy",
+			Name:        "Dizzy",
+			Icon:        "/static/images/villagers/icons/dizzy.png",
+			Species:     "Elephant",
+			Personality: "Lazy",
+			BirthMonth:  7,
+			BirthDay:    14,
+			Hobby:       "Play",
+			Catchphrase: "woo-oo",
+		},
+		{
+			VillagerID:  "dobie",
+			Name:        "Dobie",
+			Icon:        "/static/images/villagers/icons/dobie.png",
+			Species:     "Wolf",
+			Personality: "Cranky",
+			BirthMonth:  2,
+			BirthDay:    17,
+			Hobby:       "Nature",
+			Catchphrase: "ohmmm",
+		},
+		{
+			VillagerID:  "doc",
+			Name:        "Doc",
+			Icon:        "/static/images/villagers/icons/doc.png",
+			Species:     "Rabbit",
+			Personality: "Lazy",
+			BirthMonth:  3,
+			BirthDay:    16,
+			Hobby:       "Nature",
+			Catchphrase: "ol' bunny",
+		},
+		{
+			VillagerID:  "dom",
+			Name:        "Dom",
+			Icon:        "/static/images/villagers/icons/dom.png",
+			Species:     "Sheep",
+			Personality: "Jock",
+			BirthMonth:  3,
+			BirthDay:    18,
+			Hobby:       "Fitness",
+			Catchphrase: "indeed",
+		},
+		{
+			VillagerID:  "dora",
+			Name:        "Dora",
+			Icon:        "/static/images/villagers/icons/dora.png",
+			Species:     "Mouse",
+			Personality: "Normal",
+			BirthMonth:  2,
+			BirthDay:    18,
+			Hobby:       "Education",
+			Catchphrase: "squeaky",
+		},
+		{
+			VillagerID:  "dotty",
+			Name:        "Dotty",
+			Icon:        "/static/images/villagers/icons/dotty.png",
+			Species:     "Rabbit",
+			Personality: "Peppy",
+			BirthMonth:  3,
+			BirthDay:    14,
+			Hobby:       "Fashion",
+			Catchphrase: "wee one",
+		},
+		{
+			VillagerID:  "drago",
+			Name:        "Drago",
+			Icon:        "/static/images/villagers/icons/drago.png",
+			Species:     "Alligator",
+			Personality: "Lazy",
+			BirthMonth:  2,
+			BirthDay:    12,
+			Hobby:       "Play",
+			Catchphrase: "burrrn",
+		},
+		{
+			VillagerID:  "drake",
+			Name:        "Drake",
+			Icon:        "/static/images/villagers/icons/drake.png",
+			Species:     "Duck",
+			Personality: "Lazy",
+			BirthMonth:  6,
+			BirthDay:    25,
+			Hobby:       "Nature",
+			Catchphrase: "quacko",
+		},
+		{
+			VillagerID:  "drift",
+			Name:        "Drift",
+			Icon:        "/static/images/villagers/icons/drift.png",
+			Species:     "Frog",
+			Personality: "Jock",
+			BirthMonth:  10,
+			BirthDay:    9,
+			Hobby:       "Fitness",
+			Catchphrase: "brah",
+		},
+		{
+			VillagerID:  "ed",
+			Name:        "Ed",
+			Icon:        "/static/images/villagers/icons/ed.png",
+			Species:     "Horse",
+			Personality: "Smug",
+			BirthMonth:  9,
+			BirthDay:    16,
+			Hobby:       "Music",
+			Catchphrase: "greenhorn",
+		},
+		{
+			VillagerID:  "egbert",
+			Name:        "Egbert",
+			Icon:        "/static/images/villagers/icons/egbert.png",
+			Species:     "Chicken",
+			Personality: "Lazy",
+			BirthMonth:  10,
+			BirthDay:    14,
+			Hobby:       "Nature",
+			Catchphrase: "doodle-duh",
+		},
+		{
+			VillagerID:  "elise",
+			Name:        "Elise",
+			Icon:        "/static/images/villagers/icons/elise.png",
+			Species:     "Monkey",
+			Personality: "Snooty",
+			BirthMonth:  3,
+			BirthDay:    21,
+			Hobby:       "Fashion",
+			Catchphrase: "puh-lease",
+		},
+		{
+			VillagerID:  "ellie",
+			Name:        "Ellie",
+			Icon:        "/static/images/villagers/icons/ellie.png",
+			Species:     "Elephant",
+			Personality: "Normal",
+			BirthMonth:  5,
+			BirthDay:    12,
+			Hobby:       "Nature",
+			Catchphrase: "wee one",
+		},
+		{
+			VillagerID:  "elmer",
+			Name:        "Elmer",
+			Icon:        "/static/images/villagers/icons/elmer.png",
+			Species:     "Horse",
+			Personality: "Lazy",
+			BirthMonth:  10,
+			BirthDay:    5,
+			Hobby:       "Play",
+			Catchphrase: "tenderfoot",
+		},
+		{
+			VillagerID:  "eloise",
+			Name:        "Eloise",
+			Icon:        "/static/images/villagers/icons/eloise.png",
+			Species:     "Elephant",
+			Personality: "Snooty",
+			BirthMonth:  12,
+			BirthDay:    8,
+			Hobby:       "Fashion",
+			Catchphrase: "tooooot",
+		},
+		{
+			VillagerID:  "elvis",
+			Name:        "Elvis",
+			Icon:        "/static/images/villagers/icons/elvis.png",
+			Species:     "Lion",
+			Personality: "Cranky",
+			BirthMonth:  7,
+			BirthDay:    23,
+			Hobby:       "Music",
+			Catchphrase: "unh-hunh",
+		},
+		{
+			VillagerID:  "erik",
+			Name:        "Erik",
+			Icon:        "/static/images/villagers/icons/erik.png",
+			Species:     "Deer",
+			Personality: "Lazy",
+			BirthMonth:  7,
+			BirthDay:    27,
+			Hobby:       "Nature",
+			Catchphrase: "chow down",
+		},
+		{
+			VillagerID:  "etoile",
+			Name:        "Étoile",
+			Icon:        "/static/images/villagers/icons/etoile.png",
+			Species:     "Sheep",
+			Personality: "Normal",
+			BirthMonth:  12,
+			BirthDay:    25,
+			Hobby:       "Fashion",
+			Catchphrase: "sparkle",
+		},
+		{
+			VillagerID:  "eugene",
+			Name:        "Eugene",
+			Icon:        "/static/images/villagers/icons/eugene.png",
+			Species:     "Koala",
+			Personality: "Smug",
+			BirthMonth:  10,
+			BirthDay:    26,
+			Hobby:       "Music",
+			Catchphrase: "yeah buddy",
+		},
+		{
+			VillagerID:  "eunice",
+			Name:        "Eunice",
+			Icon:        "/static/images/villagers/icons/eunice.png",
+			Species:     "Sheep",
+			Personality: "Normal",
+			BirthMonth:  4,
+			BirthDay:    3,
+			Hobby:       "Nature",
+			Catchphrase: "lambchop",
+		},
+		{
+			VillagerID:  "faith",
+			Name:        "Faith",
+			Icon:        "/static/images/villagers/icons/faith.png",
+			Species:     "Koala",
+			Personality: "Normal",
+			BirthMonth:  3,
+			BirthDay:    28,
+			Hobby:       "Nature",
+			Catchphrase: "ooh la la",
+		},
+		{
+			VillagerID:  "fang",
+			Name:        "Fang",
+			Icon:        "/static/images/villagers/icons/fang.png",
+			Species:     "Wolf",
+			Personality: "Cranky",
+			BirthMonth:  12,
+			BirthDay:    18,
+			Hobby:       "Music",
+			Catchphrase: "cha-chomp",
+		},
+		{
+			VillagerID:  "fauna",
+			Name:        "Fauna",
+			Icon:        "/static/images/villagers/icons/fauna.png",
+			Species:     "Deer",
+			Personality: "Normal",
+			BirthMonth:  3,
+			BirthDay:    26,
+			Hobby:       "Nature",
+			Catchphrase: "dearie",
+		},
+		{
+			VillagerID:  "felicity",
+			Name:        "Felicity",
+			Icon:        "/static/images/villagers/icons/felicity.png",
+			Species:     "Cat",
+			Personality: "Peppy",
+			BirthMonth:  3,
+			BirthDay:    30,
+			Hobby:       "Fashion",
+			Catchphrase: "mimimi",
+		},
+		{
+			VillagerID:  "filbert",
+			Name:        "Filbert",
+			Icon:        "/static/images/villagers/icons/filbert.png",
+			Species:     "Squirrel",
+			Personality: "Lazy",
+			BirthMonth:  6,
+			BirthDay:    3,
+			Hobby:       "Play",
+			Catchphrase: "bucko",
+		},
+		{
+			VillagerID:  "flip",
+			Name:        "Flip",
+			Icon:        "/static/images/villagers/icons/flip.png",
+			Species:     "Monkey",
+			Personality: "Jock",
+			BirthMonth:  11,
+			BirthDay:    21,
+			Hobby:       "Fitness",
+			Catchphrase: "ooh-ooh",
+		},
+		{
+			VillagerID:  "flo",
+			Name:        "Flo",
+			Icon:        "/static/images/villagers/icons/flo.png",
+			Species:     "Penguin",
+			Personality: "Sisterly",
+			BirthMonth:  9,
+			BirthDay:    2,
+			Hobby:       "Music",
+			Catchphrase: "cha",
+		},
+		{
+			VillagerID:  "flora",
+			Name:        "Flora",
+			Icon:        "/static/images/villagers/icons/flora.png",
+			Species:     "Ostrich",
+			Personality: "Peppy",
+			BirthMonth:  2,
+			BirthDay:    9,
+			Hobby:       "Fashion",
+			Catchphrase: "pinky",
+		},
+		{
+			VillagerID:  "flurry",
+			Name:        "Flurry",
+			Icon:        "/static/images/villagers/icons/flurry.png",
+			Species:     "Hamster",
+			Personality: "Normal",
+			BirthMonth:  1,
+			BirthDay:    30,
+			Hobby:       "Fashion",
+			Catchphrase: "powderpuff",
+		},
+		{
+			VillagerID:  "francine",
+			Name:        "Francine",
+			Icon:        "/static/images/villagers/icons/francine.png",
+			Species:     "Rabbit",
+			Personality: "Snooty",
+			BirthMonth:  1,
+			BirthDay:    22,
+			Hobby:       "Fashion",
+			Catchphrase: "karat",
+		},
+		{
+			VillagerID:  "frank",
+			Name:        "Frank",
+			Icon:        "/static/images/villagers/icons/frank.png",
+			Species:     "Eagle",
+			Personality: "Cranky",
+			BirthMonth:  7,
+			BirthDay:    30,
+			Hobby:       "Education",
+			Catchphrase: "crushy",
+		},
+		{
+			VillagerID:  "freckles",
+			Name:        "Freckles",
+			Icon:        "/static/images/villagers/icons/freckles.png",
+			Species:     "Duck",
+			Personality: "Peppy",
+			BirthMonth:  2,
+			BirthDay:    19,
+			Hobby:       "Fashion",
+			Catchphrase: "ducky",
+		},
+		{
+			VillagerID:  "frett",
+			Name:        "Frett",
+			Icon:        "/static/images/villagers/icons/frett.png",
+			Species:     "Dog",
+			Personality: "Smug",
+			BirthMonth:  7,
+			BirthDay:    22,
+			Hobby:       "Education",
+			Catchphrase: "fetch",
+		},
+		{
+			VillagerID:  "freya",
+			Name:        "Freya",
+			Icon:        "/static/images/villagers/icons/freya.png",
+			Species:     "Wolf",
+			Personality: "Snooty",
+			BirthMonth:  12,
+			BirthDay:    14,
+			Hobby:       "Fashion",
+			Catchphrase: "uff da",
+		},
+		{
+			VillagerID:  "friga",
+			Name:        "Friga",
+			Icon:        "/static/images/villagers/icons/friga.png",
+			Species:     "Penguin",
+			Personality: "Snooty",
+			BirthMonth:  10,
+			BirthDay:    16,
+			Hobby:       "Fashion",
+			Catchphrase: "icicle",
+		},
+		{
+			VillagerID:  "frita",
+			Name:        "Frita",
+			Icon:        "/static/images/villagers/icons/frita.png",
+			Species:     "Sheep",
+			Personality: "Sisterly",
+			BirthMonth:  7,
+			BirthDay:    16,
+			Hobby:       "Play",
+			Catchphrase: "oh ewe",
+		},
+		{
+			VillagerID:  "frobert",
+			Name:        "Frobert",
+			Icon:        "/static/images/villagers/icons/frobert.png",
+			Species:     "Frog",
+			Personality: "Jock",
+			BirthMonth:  2,
+			BirthDay:    8,
+			Hobby:       "Fitness",
+			Catchphrase: "fribbit",
+		},
+		{
+			VillagerID:  "fuchsia",
+			Name:        "Fuchsia",
+			Icon:        "/static/images/villagers/icons/fuchsia.png",
+			Species:     "Deer",
+			Personality: "Sisterly",
+			BirthMonth:  9,
+			BirthDay:    19,
+			Hobby:       "Music",
+			Catchphrase: "precious",
+		},
+		{
+			VillagerID:  "gabi",
+			Name:        "Gabi",
+			Icon:        "/static/images/villagers/icons/gabi.png",
+			Species:     "Rabbit",
+			Personality: "Peppy",
+			BirthMonth:  12,
+			BirthDay:    16,
+			Hobby:       "Fashion",
+			Catchphrase: "honeybun",
+		},
+		{
+			VillagerID:  "gala",
+			Name:        "Gala",
+			Icon:        "/static/images/villagers/icons/gala.png",
+			Species:     "Pig",
+			Personality: "Normal",
+			BirthMonth:  3,
+			BirthDay:    5,
+			Hobby:       "Nature",
+			Catchphrase: "snortie",
+		},
+		{
+			VillagerID:  "gaston",
+			Name:        "Gaston",
+			Icon:        "/static/images/villagers/icons/gaston.png",
+			Species:     "Rabbit",
+			Personality: "Cranky",
+			BirthMonth:  10,
+			BirthDay:    28,
+			Hobby:       "Nature",
+			Catchphrase: "mon chou",
+		},
+		{
+			VillagerID:  "gayle",
+			Name:        "Gayle",
+			Icon:        "/static/images/villagers/icons/gayle.png",
+			Species:     "Alligator",
+			Personality: "Normal",
+			BirthMonth:  5,
+			BirthDay:    17,
+			Hobby:       "Nature",
+			Catchphrase: "snacky",
+		},
+		{
+			VillagerID:  "genji",
+			Name:        "Genji",
+			Icon:        "/static/images/villagers/icons/genji.png",
+			Species:     "Rabbit",
+			Personality: "Jock",
+			BirthMonth:  1,
+			BirthDay:    21,
+			Hobby:       "Fitness",
+			Catchphrase: "mochi",
+		},
+		{
+			VillagerID:  "gigi",
+			Name:        "Gigi",
+			Icon:        "/static/images/villagers/icons/gigi.png",
+			Species:     "Frog",
+			Personality: "Snooty",
+			BirthMonth:  8,
+			BirthDay:    11,
+			Hobby:       "Fashion",
+			Catchphrase: "ribbette",
+		},
+		{
+			VillagerID:  "gladys",
+			Name:        "Gladys",
+			Icon:        "/static/images/villagers/icons/gladys.png",
+			Species:     "Ostrich",
+			Personality: "Normal",
+			BirthMonth:  1,
+			BirthDay:    15,
+			Hobby:       "Nature",
+			Catchphrase: "stretch",
+		},
+		{
+			VillagerID:  "gloria",
+			Name:        "Gloria",
+			Icon:        "/static/images/villagers/icons/gloria.png",
+			Species:     "Duck",
+			Personality: "Snooty",
+			BirthMonth:  8,
+			BirthDay:    12,
+			Hobby:       "Fashion",
+			Catchphrase: "quah",
+		},
+		{
+			VillagerID:  "goldie",
+			Name:        "Goldie",
+			Icon:        "/static/images/villagers/icons/goldie.png",
+			Species:     "Dog",
+			Personality: "Normal",
+			BirthMonth:  12,
+			BirthDay:    27,
+			Hobby:       "Education",
+			Catchphrase: "woof",
+		},
+		{
+			VillagerID:  "gonzo",
+			Name:        "Gonzo",
+			Icon:        "/static/images/villagers/icons/gonzo.png",
+			Species:     "Koala",
+			Personality: "Cranky",
+			BirthMonth:  10,
+			BirthDay:    13,
+			Hobby:       "Nature",
+			Catchphrase: "mate",
+		},
+		{
+			VillagerID:  "goose",
+			Name:        "Goose",
+			Icon:        "/static/images/villagers/icons/goose.png",
+			Species:     "Chicken",
+			Personality: "Jock",
+			BirthMonth:  10,
+			BirthDay:    4,
+			Hobby:       "Fitness",
+			Catchphrase: "buh-kay",
+		},
+		{
+			VillagerID:  "graham",
+			Name:        "Graham",
+			Icon:        "/static/images/villagers/icons/graham.png",
+			Species:     "Hamster",
+			Personality: "Smug",
+			BirthMonth:  6,
+			BirthDay:    20,
+			Hobby:       "Education",
+			Catchphrase: "indubitably",
+		},
+		{
+			VillagerID:  "greta",
+			Name:        "Greta",
+			Icon:        "/static/images/villagers/icons/greta.png",
+			Species:     "Mouse",
+			Personality: "Snooty",
+			BirthMonth:  9,
+			BirthDay:    5,
+			Hobby:       "Fashion",
+			Catchphrase: "yelp",
+		},
+		{
+			VillagerID:  "grizzly",
+			Name:        "Grizzly",
+			Icon:        "/static/images/villagers/icons/grizzly.png",
+			Species:     "Bear",
+			Personality: "Cranky",
+			BirthMonth:  7,
+			BirthDay:    17,
+			Hobby:       "Nature",
+			Catchphrase: "grrrolf",
+		},
+		{
+			VillagerID:  "groucho",
+			Name:        "Groucho",
+			Icon:        "/static/images/villagers/icons/groucho.png",
+			Species:     "Bear",
+			Personality: "Cranky",
+			BirthMonth:  10,
+			BirthDay:    23,
+			Hobby:       "Music",
+			Catchphrase: "grumble",
+		},
+		{
+			VillagerID:  "gruff",
+			Name:        "Gruff",
+			Icon:        "/static/images/villagers/icons/gruff.png",
+			Species:     "Goat",
+			Personality: "Cranky",
+			BirthMonth:  8,
+			BirthDay:    29,
+			Hobby:       "Nature",
+			Catchphrase: "bleh eh eh",
+		},
+		{
+			VillagerID:  "gwen",
+			Name:        "Gwen",
+			Icon:        "/static/images/villagers/icons/gwen.png",
+			Species:     "Penguin",
+			Personality: "Snooty",
+			BirthMonth:  1,
+			BirthDay:    23,
+			Hobby:       "Fashion",
+			Catchphrase: "h-h-h-hon",
+		},
+		{
+			VillagerID:  "hamlet",
+			Name:        "Hamlet",
+			Icon:        "/static/images/villagers/icons/hamlet.png",
+			Species:     "Hamster",
+			Personality: "Jock",
+			BirthMonth:  5,
+			BirthDay:    30,
+			Hobby:       "Fitness",
+			Catchphrase: "hammie",
+		},
+		{
+			VillagerID:  "hamphrey",
+			Name:        "Hamphrey",
+			Icon:        "/static/images/villagers/icons/hamphrey.png",
+			Species:     "Hamster",
+			Personality: "Cranky",
+			BirthMonth:  2,
+			BirthDay:    25,
+			Hobby:       "Education",
+			Catchphrase: "snort",
+		},
+		{
+			VillagerID:  "hans",
+			Name:        "Hans",
+			Icon:        "/static/images/villagers/icons/hans.png",
+			Species:     "Gorilla",
+			Personality: "Smug",
+			BirthMonth:  12,
+			BirthDay:    5,
+			Hobby:       "Music",
+			Catchphrase: "groovy",
+		},
+		{
+			VillagerID:  "harry",
+			Name:        "Harry",
+			Icon:        "/static/images/villagers/icons/harry.png",
+			Species:     "Hippo",
+			Personality: "Cranky",
+			BirthMonth:  1,
+			BirthDay:    7,
+			Hobby:       "Nature",
+			Catchphrase: "beach bum",
+		},
+		{
+			VillagerID:  "hazel",
+			Name:        "Hazel",
+			Icon:        "/static/images/villagers/icons/hazel.png",
+			Species:     "Squirrel",
+			Personality: "Sisterly",
+			BirthMonth:  8,
+			BirthDay:    30,
+			Hobby:       "Play",
+			Catchphrase: "uni-wow",
+		},
+		{
+			VillagerID:  "henry",
+			Name:        "Henry",
+			Icon:        "/static/images/villagers/icons/henry.png",
+			Species:     "Frog",
+			Personality: "Smug",
+			BirthMonth:  9,
+			BirthDay:    21,
+			Hobby:       "Education",
+			Catchphrase: "snoozit",
+		},
+		{
+			VillagerID:  "hippeux",
+			Name:        "Hippeux",
+			Icon:        "/static/images/villagers/icons/hippeux.png",
+			Species:     "Hippo",
+			Personality: "Smug",
+			BirthMonth:  10,
+			BirthDay:    15,
+			Hobby:       "Education",
+			Catchphrase: "natch",
+		},
+		{
+			VillagerID:  "hopkins",
+			Name:        "Hopkins",
+			Icon:        "/static/images/villagers/icons/hopkins.png",
+			Species:     "Rabbit",
+			Personality: "Lazy",
+			BirthMonth:  3,
+			BirthDay:    11,
+			Hobby:       "Play",
+			Catchphrase: "thumper",
+		},
+		{
+			VillagerID:  "hopper",
+			Name:        "Hopper",
+			Icon:        "/static/images/villagers/icons/hopper.png",
+			Species:     "Penguin",
+			Personality: "Cranky",
+			BirthMonth:  4,
+			BirthDay:    6,
+			Hobby:       "Nature",
+			Catchphrase: "slushie",
+		},
+		{
+			VillagerID:  "hornsby",
+			Name:        "Hornsby",
+			Icon:        "/static/images/villagers/icons/hornsby.png",
+			Species:     "Rhino",
+			Personality: "Lazy",
+			BirthMonth:  3,
+			BirthDay:    20,
+			Hobby:       "Play",
+			Catchphrase: "schnozzle",
+		},
+		{
+			VillagerID:  "huck",
+			Name:        "Huck",
+			Icon:        "/static/images/villagers/icons/huck.png",
+			Species:     "Frog",
+			Personality: "Smug",
+			BirthMonth:  7,
+			BirthDay:    9,
+			Hobby:       "Music",
+			Catchphrase: "hopper",
+		},
+		{
+			VillagerID:  "hugh",
+			Name:        "Hugh",
+			Icon:        "/static/images/villagers/icons/hugh.png",
+			Species:     "Pig",
+			Personality: "Lazy",
+			BirthMonth:  12,
+			BirthDay:    30,
+			Hobby:       "Play",
+			Catchphrase: "snortle",
+		},
+		{
+			VillagerID:  "iggly",
+			Name:        "Iggly",
+			Icon:        "/static/images/villagers/icons/iggly.png",
+			Species:     "Penguin",
+			Personality: "Jock",
+			BirthMonth:  11,
+			BirthDay:    2,
+			Hobby:       "Fitness",
+			Catchphrase: "waddler",
+		},
+		{
+			VillagerID:  "ike",
+			Name:        "Ike",
+			Icon:        "/static/images/villagers/icons/ike.png",
+			Species:     "Bear",
+			Personality: "Cranky",
+			BirthMonth:  5,
+			BirthDay:    16,
+			Hobby:       "Nature",
+			Catchphrase: "roadie",
+		},
+		{
+			VillagerID:  "ione",
+			Name:        "Ione",
+			Icon:        "/static/images/villagers/icons/ione.png",
+			Species:     "Squirrel",
+			Personality: "Normal",
+			BirthMonth:  8,
+			BirthDay:    22,
+			Hobby:       "Fashion",
+			Catchphrase: "hey-o",
+		},
+		{
+			VillagerID:  "jacob",
+			Name:        "Jacob",
+			Icon:        "/static/images/villagers/icons/jacob.png",
+			Species:     "Bird",
+			Personality: "Lazy",
+			BirthMonth:  8,
+			BirthDay:    24,
+			Hobby:       "Nature",
+			Catchphrase: "chuuuuurp",
+		},
+		{
+			VillagerID:  "jacques",
+			Name:        "Jacques",
+			Icon:        "/static/images/villagers/icons/jacques.png",
+			Species:     "Bird",
+			Personality: "Smug",
+			BirthMonth:  6,
+			BirthDay:    22,
+			Hobby:       "Music",
+			Catchphrase: "zut alors",
+		},
+		{
+			VillagerID:  "jambette",
+			Name:        "Jambette",
+			Icon:        "/static/images/villagers/icons/jambette.png",
+			Species:     "Frog",
+			Personality: "Normal",
+			BirthMonth:  10,
+			BirthDay:    27,
+			Hobby:       "Education",
+			Catchphrase: "croak-kay",
+		},
+		{
+			VillagerID:  "jay",
+			Name:        "Jay",
+			Icon:        "/static/images/villagers/icons/jay.png",
+			Species:     "Bird",
+			Personality: "Jock",
+			BirthMonth:  7,
+			BirthDay:    17,
+			Hobby:       "Fitness",
+			Catchphrase: "heeeeeyy",
+		},
+		{
+			VillagerID:  "jeremiah",
+			Name:        "Jeremiah",
+			Icon:        "/static/images/villagers/icons/jeremiah.png",
+			Species:     "Frog",
+			Personality: "Lazy",
+			BirthMonth:  7,
+			BirthDay:    8,
+			Hobby:       "Nature",
+			Catchphrase: "nee-deep",
+		},
+		{
+			VillagerID:  "jitters",
+			Name:        "Jitters",
+			Icon:        "/static/images/villagers/icons/jitters.png",
+			Species:     "Bird",
+			Personality: "Jock",
+			BirthMonth:  2,
+			BirthDay:    2,
+			Hobby:       "Fitness",
+			Catchphrase: "bzzert",
+		},
+		{
+			VillagerID:  "joey",
+			Name:        "Joey",
+			Icon:        "/static/images/villagers/icons/joey.png",
+			Species:     "Duck",
+			Personality: "Lazy",
+			BirthMonth:  1,
+			BirthDay:    3,
+			Hobby:       "Play",
+			Catchphrase: "bleeeeeck",
+		},
+		{
+			VillagerID:  "judy",
+			Name:        "Judy",
+			Icon:        "/static/images/villagers/icons/judy.png",
+			Species:     "Cub",
+			Personality: "Snooty",
+			BirthMonth:  3,
+			BirthDay:    10,
+			Hobby:       "Fashion",
+			Catchphrase: "myohmy",
+		},
+		{
+			VillagerID:  "julia",
+			Name:        "Julia",
+			Icon:        "/static/images/villagers/icons/julia.png",
+			Species:     "Ostrich",
+			Personality: "Snooty",
+			BirthMonth:  7,
+			BirthDay:    31,
+			Hobby:       "Fashion",
+			Catchphrase: "dahling",
+		},
+		{
+			VillagerID:  "julian",
+			Name:        "Julian",
+			Icon:        "/static/images/villagers/icons/julian.png",
+			Species:     "Horse",
+			Personality: "Smug",
+			BirthMonth:  3,
+			BirthDay:    15,
+			Hobby:       "Fashion",
+			Catchphrase: "glitter",
+		},
+		{
+			VillagerID:  "june",
+			Name:        "June",
+			Icon:        "/static/images/villagers/icons/june.png",
+			Species:     "Cub",
+			Personality: "Normal",
+			BirthMonth:  5,
+			BirthDay:    21,
+			Hobby:       "Nature",
+			Catchphrase: "rainbow",
+		},
+		{
+			VillagerID:  "kabuki",
+			Name:        "Kabuki",
+			Icon:        "/static/images/villagers/icons/kabuki.png",
+			Species:     "Cat",
+			Personality: "Cranky",
+			BirthMonth:  11,
+			BirthDay:    29,
+			Hobby:       "Music",
+			Catchphrase: "meooo-OH",
+		},
+		{
+			VillagerID:  "katt",
+			Name:        "Katt",
+			Icon:        "/static/images/villagers/icons/katt.png",
+			Species:     "Cat",
+			Personality: "Sisterly",
+			BirthMonth:  4,
+			BirthDay:    27,
+			Hobby:       "Music",
+			Catchphrase: "purrty",
+		},
+		{
+			VillagerID:  "keaton",
+			Name:        "Keaton",
+			Icon:        "/static/images/villagers/icons/keaton.png",
+			Species:     "Eagle",
+			Personality: "Smug",
+			BirthMonth:  6,
+			BirthDay:    1,
+			Hobby:       "Education",
+			Catchphrase: "wingo",
+		},
+		{
+			VillagerID:  "ken",
+			Name:        "Ken",
+			Icon:        "/static/images/villagers/icons/ken.png",
+			Species:     "Chicken",
+			Personality: "Smug",
+			BirthMonth:  12,
+			BirthDay:    23,
+			Hobby:       "Music",
+			Catchphrase: "no doubt",
+		},
+		{
+			VillagerID:  "ketchup",
+			Name:        "Ketchup",
+			Icon:        "/static/images/villagers/icons/ketchup.png",
+			Species:     "Duck",
+			Personality: "Peppy",
+			BirthMonth:  7,
+			BirthDay:    27,
+			Hobby:       "Fashion",
+			Catchphrase: "bitty",
+		},
+		{
+			VillagerID:  "kevin",
+			Name:        "Kevin",
+			Icon:        "/static/images/villagers/icons/kevin.png",
+			Species:     "Pig",
+			Personality: "Jock",
+			BirthMonth:  4,
+			BirthDay:    26,
+			Hobby:       "Fitness",
+			Catchphrase: "weiner",
+		},
+		{
+			VillagerID:  "kid-cat",
+			Name:        "Kid Cat",
+			Icon:        "/static/images/villagers/icons/kid-cat.png",
+			Species:     "Cat",
+			Personality: "Jock",
+			BirthMonth:  8,
+			BirthDay:    1,
+			Hobby:       "Fitness",
+			Catchphrase: "psst",
+		},
+		{
+			VillagerID:  "kidd",
+			Name:        "Kidd",
+			Icon:        "/static/images/villagers/icons/kidd.png",
+			Species:     "Goat",
+			Personality: "Smug",
+			BirthMonth:  6,
+			BirthDay:    28,
+			Hobby:       "Music",
+			Catchphrase: "wut",
+		},
+		{
+			VillagerID:  "kiki",
+			Name:        "Kiki",
+			Icon:        "/static/images/villagers/icons/kiki.png",
+			Species:     "Cat",
+			Personality: "Normal",
+			BirthMonth:  10,
+			BirthDay:    8,
+			Hobby:       "Nature",
+			Catchphrase: "kitty cat",
+		},
+		{
+			VillagerID:  "kitt",
+			Name:        "Kitt",
+			Icon:        "/static/images/villagers/icons/kitt.png",
+			Species:     "Kangaroo",
+			Personality: "Normal",
+			BirthMonth:  10,
+			BirthDay:    11,
+			Hobby:       "Education",
+			Catchphrase: "child",
+		},
+		{
+			VillagerID:  "kitty",
+			Name:        "Kitty",
+			Icon:        "/static/images/villagers/icons/kitty.png",
+			Species:     "Cat",
+			Personality: "Snooty",
+			BirthMonth:  2,
+			BirthDay:    15,
+			Hobby:       "Fashion",
+			Catchphrase: "mew",
+		},
+		{
+			VillagerID:  "klaus",
+			Name:        "Klaus",
+			Icon:        "/static/images/villagers/icons/klaus.png",
+			Species:     "Bear",
+			Personality: "Smug",
+			BirthMonth:  3,
+			BirthDay:    31,
+			Hobby:       "Music",
+			Catchphrase: "strudel",
+		},
+		{
+			VillagerID:  "knox",
+			Name:        "Knox",
+			Icon:        "/static/images/villagers/icons/knox.png",
+			Species:     "Chicken",
+			Personality: "Cranky",
+			BirthMonth:  11,
+			BirthDay:    23,
+			Hobby:       "Education",
+			Catchphrase: "cluckling",
+		},
+		{
+			VillagerID:  "kody",
+			Name:        "Kody",
+			Icon:        "/static/images/villagers/icons/kody.png",
+			Species:     "Cub",
+			Personality: "Jock",
+			BirthMonth:  9,
+			BirthDay:    28,
+			Hobby:       "Fitness",
+			Catchphrase: "grah-grah",
+		},
+		{
+			VillagerID:  "kyle",
+			Name:        "Kyle",
+			Icon:        "/static/images/villagers/icons/kyle.png",
+			Species:     "Wolf",
+			Personality: "Smug",
+			BirthMonth:  12,
+			BirthDay:    6,
+			Hobby:       "Music",
+			Catchphrase: "alpha",
+		},
+		{
+			VillagerID:  "leonardo",
+			Name:        "Leonardo",
+			Icon:        "/static/images/villagers/icons/leonardo.png",
+			Species:     "Tiger",
+			Personality: "Jock",
+			BirthMonth:  5,
+			BirthDay:    15,
+			Hobby:       "Fitness",
+			Catchphrase: "flexin'",
+		},
+		{
+			VillagerID:  "leopold",
+			Name:        "Leopold",
+			Icon:        "/static/images/villagers/icons/leopold.png",
+			Species:     "Lion",
+			Personality: "Smug",
+			BirthMonth:  8,
+			BirthDay:    14,
+			Hobby:       "Education",
+			Catchphrase: "lion cub",
+		},
+		{
+			VillagerID:  "lily",
+			Name:        "Lily",
+			Icon:        "/static/images/villagers/icons/lily.png",
+			Species:     "Frog",
+			Personality: "Normal",
+			BirthMonth:  2,
+			BirthDay:    4,
+			Hobby:       "Nature",
+			Catchphrase: "toady",
+		},
+		{
+			VillagerID:  "limberg",
+			Name:        "Limberg",
+			Icon:        "/static/images/villagers/icons/limberg.png",
+			Species:     "Mouse",
+			Personality: "Cranky",
+			BirthMonth:  10,
+			BirthDay:    17,
+			Hobby:       "Nature",
+			Catchphrase: "squinky",
+		},
+		{
+			VillagerID:  "lionel",
+			Name:        "Lionel",
+			Icon:        "/static/images/villagers/icons/lionel.png",
+			Species:     "Lion",
+			Personality: "Smug",
+			BirthMonth:  7,
+			BirthDay:    29,
+			Hobby:       "Education",
+			Catchphrase: "precisely",
+		},
+		{
+			VillagerID:  "lobo",
+			Name:        "Lobo",
+			Icon:        "/static/images/villagers/icons/lobo.png",
+			Species:     "Wolf",
+			Personality: "Cranky",
+			BirthMonth:  11,
+			BirthDay:    5,
+			Hobby:       "Play",
+			Catchphrase: "ah-rooooo",
+		},
+		{
+			VillagerID:  "lolly",
+			Name:        "Lolly",
+			Icon:        "/static/images/villagers/icons/lolly.png",
+			Species:     "Cat",
+			Personality: "Normal",
+			BirthMonth:  3,
+			BirthDay:    27,
+			Hobby:       "Education",
+			Catchphrase: "bonbon",
+		},
+		{
+			VillagerID:  "lopez",
+			Name:        "Lopez",
+			Icon:        "/static/images/villagers/icons/lopez.png",
+			Species:     "Deer",
+			Personality: "Smug",
+			BirthMonth:  8,
+			BirthDay:    20,
+			Hobby:       "Music",
+			Catchphrase: "buckaroo",
+		},
+		{
+			VillagerID:  "louie",
+			Name:        "Louie",
+			Icon:        "/static/images/villagers/icons/louie.png",
+			Species:     "Gorilla",
+			Personality: "Jock",
+			BirthMonth:  3,
+			BirthDay:    26,
+			Hobby:       "Fitness",
+			Catchphrase: "hoo hoo ha",
+		},
+		{
+			VillagerID:  "lucha",
+			Name:        "Lucha",
+			Icon:        "/static/images/villagers/icons/lucha.png",
+			Species:     "Bird",
+			Personality: "Smug",
+			BirthMonth:  12,
+			BirthDay:    12,
+			Hobby:       "Fitness",
+			Catchphrase: "cacaw",
+		},
+		{
+			VillagerID:  "lucky",
+			Name:        "Lucky",
+			Icon:        "/static/images/villagers/icons/lucky.png",
+			Species:     "Dog",
+			Personality: "Lazy",
+			BirthMonth:  11,
+			BirthDay:    4,
+			Hobby:       "Play",
+			Catchphrase: "rrr-owch",
+		},
+		{
+			VillagerID:  "lucy",
+			Name:        "Lucy",
+			Icon:        "/static/images/villagers/icons/lucy.png",
+			Species:     "Pig",
+			Personality: "Normal",
+			BirthMonth:  6,
+			BirthDay:    2,
+			Hobby:       "Nature",
+			Catchphrase: "snoooink",
+		},
+		{
+			VillagerID:  "lyman",
+			Name:        "Lyman",
+			Icon:        "/static/images/villagers/icons/lyman.png",
+			Species:     "Koala",
+			Personality: "Jock",
+			BirthMonth:  10,
+			BirthDay:    12,
+			Hobby:       "Fitness",
+			Catchphrase: "chips",
+		},
+		{
+			VillagerID:  "mac",
+			Name:        "Mac",
+			Icon:        "/static/images/villagers/icons/mac.png",
+			Species:     "Dog",
+			Personality: "Jock",
+			BirthMonth:  11,
+			BirthDay:    11,
+			Hobby:       "Fitness",
+			Catchphrase: "woo woof",
+		},
+		{
+			VillagerID:  "maddie",
+			Name:        "Maddie",
+			Icon:        "/static/images/villagers/icons/maddie.png",
+			Species:     "Dog",
+			Personality: "Peppy",
+			BirthMonth:  1,
+			BirthDay:    11,
+			Hobby:       "Fashion",
+			Catchphrase: "yippee",
+		},
+		{
+			VillagerID:  "maelle",
+			Name:        "Maelle",
+			Icon:        "/static/images/villagers/icons/maelle.png",
+			Species:     "Duck",
+			Personality: "Snooty",
+			BirthMonth:  4,
+			BirthDay:    8,
+			Hobby:       "Fashion",
+			Catchphrase: "duckling",
+		},
+		{
+			VillagerID:  "maggie",
+			Name:        "Maggie",
+			Icon:        "/static/images/villagers/icons/maggie.png",
+			Species:     "Pig",
+			Personality: "Normal",
+			BirthMonth:  9,
+			BirthDay:    3,
+			Hobby:       "Nature",
+			Catchphrase: "schep",
+		},
+		{
+			VillagerID:  "mallary",
+			Name:        "Mallary",
+			Icon:        "/static/images/villagers/icons/mallary.png",
+			Species:     "Duck",
+			Personality: "Snooty",
+			BirthMonth:  11,
+			BirthDay:    17,
+			Hobby:       "Fashion",
+			Catchphrase: "quackpth",
+		},
+		{
+			VillagerID:  "maple",
+			Name:        "Maple",
+			Icon:        "/static/images/villagers/icons/maple.png",
+			Species:     "Cub",
+			Personality: "Normal",
+			BirthMonth:  6,
+			BirthDay:    15,
+			Hobby:       "Nature",
+			Catchphrase: "honeycomb",
+		},
+		{
+			VillagerID:  "marcel",
+			Name:        "Marcel",
+			Icon:        "/static/images/villagers/icons/marcel.png",
+			Species:     "Dog",
+			Personality: "Lazy",
+			BirthMonth:  12,
+			BirthDay:    31,
+			Hobby:       "Play",
+			Catchphrase: "non",
+		},
+		{
+			VillagerID:  "marcie",
+			Name:        "Marcie",
+			Icon:        "/static/images/villagers/icons/marcie.png",
+			Species:     "Kangaroo",
+			Personality: "Normal",
+			BirthMonth:  5,
+			BirthDay:    31,
+			Hobby:       "Nature",
+			Catchphrase: "pouches",
+		},
+		{
+			VillagerID:  "margie",
+			Name:        "Margie",
+			Icon:        "/static/images/villagers/icons/margie.png",
+			Species:     "Elephant",
+			Personality: "Normal",
+			BirthMonth:  1,
+			BirthDay:    28,
+			Hobby:       "Education",
+			Catchphrase: "tootie",
+		},
+		{
+			VillagerID:  "marina",
+			Name:        "Marina",
+			Icon:        "/static/images/villagers/icons/marina.png",
+			Species:     "Octopus",
+			Personality: "Normal",
+			BirthMonth:  6,
+			BirthDay:    26,
+			Hobby:       "Music",
+			Catchphrase: "blurp",
+		},
+		{
+			VillagerID:  "marlo",
+			Name:        "Marlo",
+			Icon:        "/static/images/villagers/icons/marlo.png",
+			Species:     "Hamster",
+			Personality: "Cranky",
+			BirthMonth:  3,
+			BirthDay:    21,
+			Hobby:       "Music",
+			Catchphrase: "sparky",
+		},
+		{
+			VillagerID:  "marshal",
+			Name:        "Marshal",
+			Icon:        "/static/images/villagers/icons/marshal.png",
+			Species:     "Squirrel",
+			Personality: "Smug",
+			BirthMonth:  9,
+			BirthDay:    29,
+			Hobby:       "Music",
+			Catchphrase: "sulky",
+		},
+		{
+			VillagerID:  "marty",
+			Name:        "Marty",
+			Icon:        "/static/images/villagers/icons/marty.png",
+			Species:     "Cub",
+			Personality: "Lazy",
+			BirthMonth:  4,
+			BirthDay:    16,
+			Hobby:       "Play",
+			Catchphrase: "unh-hunh",
+		},
+		{
+			VillagerID:  "mathilda",
+			Name:        "Mathilda",
+			Icon:        "/static/images/villagers/icons/mathilda.png",
+			Species:     "Kangaroo",
+			Personality: "Snooty",
+			BirthMonth:  11,
+			BirthDay:    12,
+			Hobby:       "Fashion",
+			Catchphrase: "wee baby",
+		},
+		{
+			VillagerID:  "megan",
+			Name:        "Megan",
+			Icon:        "/static/images/villagers/icons/megan.png",
+			Species:     "Bear",
+			Personality: "Normal",
+			BirthMonth:  3,
+			BirthDay:    13,
+			Hobby:       "Nature",
+			Catchphrase: "sundae",
+		},
+		{
+			VillagerID:  "melba",
+			Name:        "Melba",
+			Icon:        "/static/images/villagers/icons/melba.png",
+			Species:     "Koala",
+			Personality: "Normal",
+			BirthMonth:  4,
+			BirthDay:    12,
+			Hobby:       "Education",
+			Catchphrase: "toasty",
+		},
+		{
+			VillagerID:  "merengue",
+			Name:        "Merengue",
+			Icon:        "/static/images/villagers/icons/merengue.png",
+			Species:     "Rhino",
+			Personality: "Normal",
+			BirthMonth:  3,
+			BirthDay:    19,
+			Hobby:       "Fashion",
+			Catchphrase: "shortcake",
+		},
+		{
+			VillagerID:  "merry",
+			Name:        "Merry",
+			Icon:        "/static/images/villagers/icons/merry.png",
+			Species:     "Cat",
+			Personality: "Peppy",
+			BirthMonth:  6,
+			BirthDay:    29,
+			Hobby:       "Fashion",
+			Catchphrase: "mweee",
+		},
+		{
+			VillagerID:  "midge",
+			Name:        "Midge",
+			Icon:        "/static/images/villagers/icons/midge.png",
+			Species:     "Bird",
+			Personality: "Normal",
+			BirthMonth:  3,
+			BirthDay:    12,
+			Hobby:       "Nature",
+			Catchphrase: "tweedledee",
+		},
+		{
+			VillagerID:  "mint",
+			Name:        "Mint",
+			Icon:        "/static/images/villagers/icons/mint.png",
+			Species:     "Squirrel",
+			Personality: "Snooty",
+			BirthMonth:  5,
+			BirthDay:    2,
+			Hobby:       "Fashion",
+			Catchphrase: "ahhhhhh",
+		},
+		{
+			VillagerID:  "mira",
+			Name:        "Mira",
+			Icon:        "/static/images/villagers/icons/mira.png",
+			Species:     "Rabbit",
+			Personality: "Sisterly",
+			BirthMonth:  7,
+			BirthDay:    6,
+			Hobby:       "Music",
+			Catchphrase: "cottontail",
+		},
+		{
+			VillagerID:  "miranda",
+			Name:        "Miranda",
+			Icon:        "/static/images/villagers/icons/miranda.png",
+			Species:     "Duck",
+			Personality: "Snooty",
+			BirthMonth:  4,
+			BirthDay:    23,
+			Hobby:       "Fashion",
+			Catchphrase: "quackulous",
+		},
+		{
+			VillagerID:  "mitzi",
+			Name:        "Mitzi",
+			Icon:        "/static/images/villagers/icons/mitzi.png",
+			Species:     "Cat",
+			Personality: "Normal",
+			BirthMonth:  9,
+			BirthDay:    25,
+			Hobby:       "Nature",
+			Catchphrase: "meow",
+		},
+		{
+			VillagerID:  "moe",
+			Name:        "Moe",
+			Icon:        "/static/images/villagers/icons/moe.png",
+			Species:     "Cat",
+			Personality: "Lazy",
+			BirthMonth:  1,
+			BirthDay:    12,
+			Hobby:       "Play",
+			Catchphrase: "myawn",
+		},
+		{
+			VillagerID:  "molly",
+			Name:        "Molly",
+			Icon:        "/static/images/villagers/icons/molly.png",
+			Species:     "Duck",
+			Personality: "Normal",
+			BirthMonth:  3,
+			BirthDay:    7,
+			Hobby:       "Nature",
+			Catchphrase: "quackidee",
+		},
+		{
+			VillagerID:  "monique",
+			Name:        "Monique",
+			Icon:        "/static/images/villagers/icons/monique.png",
+			Species:     "Cat",
+			Personality: "Snooty",
+			BirthMonth:  9,
+			BirthDay:    30,
+			Hobby:       "Fashion",
+			Catchphrase: "pffffft",
+		},
+		{
+			VillagerID:  "monty",
+			Name:        "Monty",
+			Icon:        "/static/images/villagers/icons/monty.png",
+			Species:     "Monkey",
+			Personality: "Cranky",
+			BirthMonth:  12,
+			BirthDay:    7,
+			Hobby:       "Education",
+			Catchphrase: "g'tang",
+		},
+		{
+			VillagerID:  "moose",
+			Name:        "Moose",
+			Icon:        "/static/images/villagers/icons/moose.png",
+			Species:     "Mouse",
+			Personality: "Jock",
+			BirthMonth:  9,
+			BirthDay:    13,
+			Hobby:       "Fitness",
+			Catchphrase: "shorty",
+		},
+		{
+			VillagerID:  "mott",
+			Name:        "Mott",
+			Icon:        "/static/images/villagers/icons/mott.png",
+			Species:     "Lion",
+			Personality: "Jock",
+			BirthMonth:  7,
+			BirthDay:    10,
+			Hobby:       "Fitness",
+			Catchphrase: "cagey",
+		},
+		{
+			VillagerID:  "muffy",
+			Name:        "Muffy",
+			Icon:        "/static/images/villagers/icons/muffy.png",
+			Species:     "Sheep",
+			Personality: "Sisterly",
+			BirthMonth:  2,
+			BirthDay:    14,
+			Hobby:       "Music",
+			Catchphrase: "nightshade",
+		},
+		{
+			VillagerID:  "murphy",
+			Name:        "Murphy",
+			Icon:        "/static/images/villagers/icons/murphy.png",
+			Species:     "Cub",
+			Personality: "Cranky",
+			BirthMonth:  12,
+			BirthDay:    29,
+			Hobby:       "Nature",
+			Catchphrase: "laddie",
+		},
+		{
+			VillagerID:  "nan",
+			Name:        "Nan",
+			Icon:        "/static/images/villagers/icons/nan.png",
+			Species:     "Goat",
+			Personality: "Normal",
+			BirthMonth:  8,
+			BirthDay:    24,
+			Hobby:       "Nature",
+			Catchphrase: "kid",
+		},
+		{
+			VillagerID:  "nana",
+			Name:        "Nana",
+			Icon:        "/static/images/villagers/icons/nana.png",
+			Species:     "Monkey",
+			Personality: "Normal",
+			BirthMonth:  8,
+			BirthDay:    23,
+			Hobby:       "Nature",
+			Catchphrase: "po po",
+		},
+		{
+			VillagerID:  "naomi",
+			Name:        "Naomi",
+			Icon:        "/static/images/villagers/icons/naomi.png",
+			Species:     "Cow",
+			Personality: "Snooty",
+			BirthMonth:  2,
+			BirthDay:    28,
+			Hobby:       "Fashion",
+			Catchphrase: "moolah",
+		},
+		{
+			VillagerID:  "nate",
+			Name:        "Nate",
+			Icon:        "/static/images/villagers/icons/nate.png",
+			Species:     "Bear",
+			Personality: "Lazy",
+			BirthMonth:  8,
+			BirthDay:    16,
+			Hobby:       "Play",
+			Catchphrase: "yawwwn",
+		},
+		{
+			VillagerID:  "nibbles",
+			Name:        "Nibbles",
+			Icon:        "/static/images/villagers/icons/nibbles.png",
+			Species:     "Squirrel",
+			Personality: "Peppy",
+			BirthMonth:  7,
+			BirthDay:    19,
+			Hobby:       "Fashion",
+			Catchphrase: "niblet",
+		},
+		{
+			VillagerID:  "norma",
+			Name:        "Norma",
+			Icon:        "/static/images/villagers/icons/norma.png",
+			Species:     "Cow",
+			Personality: "Normal",
+			BirthMonth:  9,
+			BirthDay:    20,
+			Hobby:       "Nature",
+			Catchphrase: "hoof",
+		},
+		{
+			VillagerID:  "ohare",
+			Name:        "O'Hare",
+			Icon:        "/static/images/villagers/icons/ohare.png",
+			Species:     "Rabbit",
+			Personality: "Smug",
+			BirthMonth:  7,
+			BirthDay:    24,
+			Hobby:       "Music",
+			Catchphrase: "amigo",
+		},
+		{
+			VillagerID:  "octavian",
+			Name:        "Octavian",
+			Icon:        "/static/images/villagers/icons/octavian.png",
+			Species:     "Octopus",
+			Personality: "Cranky",
+			BirthMonth:  9,
+			BirthDay:    20,
+			Hobby:       "Nature",
+			Catchphrase: "sucker",
+		},
+		{
+			VillagerID:  "olaf",
+			Name:        "Olaf",
+			Icon:        "/static/images/villagers/icons/olaf.png",
+			Species:     "Anteater",
+			Personality: "Smug",
+			BirthMonth:  5,
+			BirthDay:    19,
+			Hobby:       "Music",
+			Catchphrase: "whiffa",
+		},
+		{
+			VillagerID:  "olive",
+			Name:        "Olive",
+			Icon:        "/static/images/villagers/icons/olive.png",
+			Species:     "Cub",
+			Personality: "Normal",
+			BirthMonth:  7,
+			BirthDay:    12,
+			Hobby:       "Education",
+			Catchphrase: "sweet pea",
+		},
+		{
+			VillagerID:  "olivia",
+			Name:        "Olivia",
+			Icon:        "/static/images/villagers/icons/olivia.png",
+			Species:     "Cat",
+			Personality: "Snooty",
+			BirthMonth:  2,
+			BirthDay:    3,
+			Hobby:       "Fashion",
+			Catchphrase: "purrr",
+		},
+		{
+			VillagerID:  "opal",
+			Name:        "Opal",
+			Icon:        "/static/images/villagers/icons/opal.png",
+			Species:     "Elephant",
+			Personality: "Snooty",
+			BirthMonth:  1,
+			BirthDay:    20,
+			Hobby:       "Fashion",
+			Catchphrase: "snoot",
+		},
+		{
+			VillagerID:  "ozzie",
+			Name:        "Ozzie",
+			Icon:        "/static/images/villagers/icons/ozzie.png",
+			Species:     "Koala",
+			Personality: "Lazy",
+			BirthMonth:  5,
+			BirthDay:    7,
+			Hobby:       "Play",
+			Catchphrase: "ol' bear",
+		},
+		{
+			VillagerID:  "pancetti",
+			Name:        "Pancetti",
+			Icon:        "/static/images/villagers/icons/pancetti.png",
+			Species:     "Pig",
+			Personality: "Snooty",
+			BirthMonth:  11,
+			BirthDay:    14,
+			Hobby:       "Fashion",
+			Catchphrase: "sooey",
+		},
+		{
+			VillagerID:  "pango",
+			Name:        "Pango",
+			Icon:        "/static/images/villagers/icons/pango.png",
+			Species:     "Anteater",
+			Personality: "Peppy",
+			BirthMonth:  11,
+			BirthDay:    9,
+			Hobby:       "Fashion",
+			Catchphrase: "snooooof",
+		},
+		{
+			VillagerID:  "paolo",
+			Name:        "Paolo",
+			Icon:        "/static/images/villagers/icons/paolo.png",
+			Species:     "Elephant",
+			Personality: "Lazy",
+			BirthMonth:  5,
+			BirthDay:    5,
+			Hobby:       "Nature",
+			Catchphrase: "pal",
+		},
+		{
+			VillagerID:  "papi",
+			Name:        "Papi",
+			Icon:        "/static/images/villagers/icons/papi.png",
+			Species:     "Horse",
+			Personality: "Lazy",
+			BirthMonth:  1,
+			BirthDay:    10,
+			Hobby:       "Play",
+			Catchphrase: "haaay",
+		},
+		{
+			VillagerID:  "pashmina",
+			Name:        "Pashmina",
+			Icon:        "/static/images/villagers/icons/pashmina.png",
+			Species:     "Goat",
+			Personality: "Sisterly",
+			BirthMonth:  12,
+			BirthDay:    26,
+			Hobby:       "Play",
+			Catchphrase: "kidders",
+		},
+		{
+			VillagerID:  "pate",
+			Name:        "Pate",
+			Icon:        "/static/images/villagers/icons/pate.png",
+			Species:     "Duck",
+			Personality: "Peppy",
+			BirthMonth:  2,
+			BirthDay:    23,
+			Hobby:       "Fashion",
+			Catchphrase: "quackle",
+		},
+		{
+			VillagerID:  "patty",
+			Name:        "Patty",
+			Icon:        "/static/images/villagers/icons/patty.png",
+			Species:     "Cow",
+			Personality: "Peppy",
+			BirthMonth:  5,
+			BirthDay:    10,
+			Hobby:       "Fashion",
+			Catchphrase: "how-now",
+		},
+		{
+			VillagerID:  "paula",
+			Name:        "Paula",
+			Icon:        "/static/images/villagers/icons/paula.png",
+			Species:     "Bear",
+			Personality: "Sisterly",
+			BirthMonth:  3,
+			BirthDay:    22,
+			Hobby:       "Fitness",
+			Catchphrase: "yodelay",
+		},
+		{
+			VillagerID:  "peaches",
+			Name:        "Peaches",
+			Icon:        "/static/images/villagers/icons/peaches.png",
+			Species:     "Horse",
+			Personality: "Normal",
+			BirthMonth:  11,
+			BirthDay:    28,
+			Hobby:       "Nature",
+			Catchphrase: "neighbor",
+		},
+		{
+			VillagerID:  "peanut",
+			Name:        "Peanut",
+			Icon:        "/static/images/villagers/icons/peanut.png",
+			Species:     "Squirrel",
+			Personality: "Peppy",
+			BirthMonth:  6,
+			BirthDay:    8,
+			Hobby:       "Fashion",
+			Catchphrase: "slacker",
+		},
+		{
+			VillagerID:  "pecan",
+			Name:        "Pecan",
+			Icon:        "/static/images/villagers/icons/pecan.png",
+			Species:     "Squirrel",
+			Personality: "Snooty",
+			BirthMonth:  9,
+			BirthDay:    10,
+			Hobby:       "Fashion",
+			Catchphrase: "chipmunk",
+		},
+		{
+			VillagerID:  "peck",
+			Name:        "Peck",
+			Icon:        "/static/images/villagers/icons/peck.png",
+			Species:     "Bird",
+			Personality: "Jock",
+			BirthMonth:  7,
+			BirthDay:    23,
+			Hobby:       "Fitness",
+			Catchphrase: "crunch",
+		},
+		{
+			VillagerID:  "peewee",
+			Name:        "Peewee",
+			Icon:        "/static/images/villagers/icons/peewee.png",
+			Species:     "Gorilla",
+			Personality: "Cranky",
+			BirthMonth:  9,
+			BirthDay:    11,
+			Hobby:       "Fitness",
+			Catchphrase: "li'l dude",
+		},
+		{
+			VillagerID:  "peggy",
+			Name:        "Peggy",
+			Icon:        "/static/images/villagers/icons/peggy.png",
+			Species:     "Pig",
+			Personality: "Peppy",
+			BirthMonth:  5,
+			BirthDay:    23,
+			Hobby:       "Fashion",
+			Catchphrase: "shweetie",
+		},
+		{
+			VillagerID:  "pekoe",
+			Name:        "Pekoe",
+			Icon:        "/static/images/villagers/icons/pekoe.png",
+			Species:     "Cub",
+			Personality: "Normal",
+			BirthMonth:  5,
+			BirthDay:    18,
+			Hobby:       "Education",
+			Catchphrase: "bud",
+		},
+		{
+			VillagerID:  "penelope",
+			Name:        "Penelope",
+			Icon:        "/static/images/villagers/icons/penelope.png",
+			Species:     "Mouse",
+			Personality: "Peppy",
+			BirthMonth:  2,
+			BirthDay:    5,
+			Hobby:       "Fashion",
+			Catchphrase: "oh bow",
+		},
+		{
+			VillagerID:  "petri",
+			Name:        "Petri",
+			Icon:        "/static/images/villagers/icons/petri.png",
+			Species:     "Mouse",
+			Personality: "Snooty",
+			BirthMonth:  2,
+			BirthDay:    2,
+			Hobby:       "Education",
+			Catchphrase: "hey hey",
+		},
+		{
+			VillagerID:  "phil",
+			Name:        "Phil",
+			Icon:        "/static/images/villagers/icons/phil.png",
+			Species:     "Ostrich",
+			Personality: "Smug",
+			BirthMonth:  11,
+			BirthDay:    27,
+			Hobby:       "Music",
+			Catchphrase: "hurk",
+		},
+		{
+			VillagerID:  "phoebe",
+			Name:        "Phoebe",
+			Icon:        "/static/images/villagers/icons/phoebe.png",
+			Species:     "Ostrich",
+			Personality: "Sisterly",
+			BirthMonth:  4,
+			BirthDay:    22,
+			Hobby:       "Music",
+			Catchphrase: "sparky",
+		},
+		{
+			VillagerID:  "pierce",
+			Name:        "Pierce",
+			Icon:        "/static/images/villagers/icons/pierce.png",
+			Species:     "Eagle",
+			Personality: "Jock",
+			BirthMonth:  1,
+			BirthDay:    8,
+			Hobby:       "Fitness",
+			Catchphrase: "hawkeye",
+		},
+		{
+			VillagerID:  "pietro",
+			Name:        "Pietro",
+			Icon:        "/static/images/villagers/icons/pietro.png",
+			Species:     "Sheep",
+			Personality: "Smug",
+			BirthMonth:  4,
+			BirthDay:    19,
+			Hobby:       "Music",
+			Catchphrase: "baa-baa-baa",
+		},
+		{
+			VillagerID:  "pinky",
+			Name:        "Pinky",
+			Icon:        "/static/images/villagers/icons/pinky.png",
+			Species:     "Bear",
+			Personality: "Peppy",
+			BirthMonth:  9,
+			BirthDay:    9,
+			Hobby:       "Fashion",
+			Catchphrase: "wah",
+		},
+		{
+			VillagerID:  "piper",
+			Name:        "Piper",
+			Icon:        "/static/images/villagers/icons/piper.png",
+			Species:     "Bird",
+			Personality: "Peppy",
+			BirthMonth:  4,
+			BirthDay:    18,
+			Hobby:       "Fashion",
+			Catchphrase: "chickadee",
+		},
+		{
+			VillagerID:  "pippy",
+			Name:        "Pippy",
+			Icon:        "/static/images/villagers/icons/pippy.png",
+			Species:     "Rabbit",
+			Personality: "Peppy",
+			BirthMonth:  6,
+			BirthDay:    14,
+			Hobby:       "Fashion",
+			Catchphrase: "li'l hare",
+		},
+		{
+			VillagerID:  "plucky",
+			Name:        "Plucky",
+			Icon:        "/static/images/villagers/icons/plucky.png",
+			Species:     "Chicken",
+			Personality: "Sisterly",
+			BirthMonth:  10,
+			BirthDay:    12,
+			Hobby:       "Play",
+			Catchphrase: "chicky-poo",
+		},
+		{
+			VillagerID:  "pompom",
+			Name:        "Pompom",
+			Icon:        "/static/images/villagers/icons/pompom.png",
+			Species:     "Duck",
+			Personality: "Peppy",
+			BirthMonth:  2,
+			BirthDay:    11,
+			Hobby:       "Fitness",
+			Catchphrase: "rah rah",
+		},
+		{
+			VillagerID:  "poncho",
+			Name:        "Poncho",
+			Icon:        "/static/images/villagers/icons/poncho.png",
+			Species:     "Cub",
+			Personality: "Jock",
+			BirthMonth:  1,
+			BirthDay:    2,
+			Hobby:       "Fitness",
+			Catchphrase: "li'l bear",
+		},
+		{
+			VillagerID:  "poppy",
+			Name:        "Poppy",
+			Icon:        "/static/images/villagers/icons/poppy.png",
+			Species:     "Squirrel",
+			Personality: "Normal",
+			BirthMonth:  8,
+			BirthDay:    5,
+			Hobby:       "Play",
+			Catchphrase: "nutty",
+		},
+		{
+			VillagerID:  "portia",
+			Name:        "Portia",
+			Icon:        "/static/images/villagers/icons/portia.png",
+			Species:     "Dog",
+			Personality: "Snooty",
+			BirthMonth:  10,
+			BirthDay:    25,
+			Hobby:       "Fashion",
+			Catchphrase: "ruffian",
+		},
+		{
+			VillagerID:  "prince",
+			Name:        "Prince",
+			Icon:        "/static/images/villagers/icons/prince.png",
+			Species:     "Frog",
+			Personality: "Lazy",
+			BirthMonth:  7,
+			BirthDay:    21,
+			Hobby:       "Play",
+			Catchphrase: "burrup",
+		},
+		{
+			VillagerID:  "puck",
+			Name:        "Puck",
+			Icon:        "/static/images/villagers/icons/puck.png",
+			Species:     "Penguin",
+			Personality: "Lazy",
+			BirthMonth:  2,
+			BirthDay:    21,
+			Hobby:       "Play",
+			Catchphrase: "brrrrrrrrr",
+		},
+		{
+			VillagerID:  "puddles",
+			Name:        "Puddles",
+			Icon:        "/static/images/villagers/icons/puddles.png",
+			Species:     "Frog",
+			Personality: "Peppy",
+			BirthMonth:  1,
+			BirthDay:    13,
+			Hobby:       "Fashion",
+			Catchphrase: "splish",
+		},
+		{
+			VillagerID:  "pudge",
+			Name:        "Pudge",
+			Icon:        "/static/images/villagers/icons/pudge.png",
+			Species:     "Cub",
+			Personality: "Lazy",
+			BirthMonth:  6,
+			BirthDay:    11,
+			Hobby:       "Play",
+			Catchphrase: "pudgy",
+		},
+		{
+			VillagerID:  "punchy",
+			Name:        "Punchy",
+			Icon:        "/static/images/villagers/icons/punchy.png",
+			Species:     "Cat",
+			Personality: "Lazy",
+			BirthMonth:  4,
+			BirthDay:    11,
+			Hobby:       "Play",
+			Catchphrase: "mrmpht",
+		},
+		{
+			VillagerID:  "purrl",
+			Name:        "Purrl",
+			Icon:        "/static/images/villagers/icons/purrl.png",
+			Species:     "Cat",
+			Personality: "Snooty",
+			BirthMonth:  5,
+			BirthDay:    29,
+			Hobby:       "Fashion",
+			Catchphrase: "kitten",
+		},
+		{
+			VillagerID:  "queenie",
+			Name:        "Queenie",
+			Icon:        "/static/images/villagers/icons/queenie.png",
+			Species:     "Ostrich",
+			Personality: "Snooty",
+			BirthMonth:  11,
+			BirthDay:    13,
+			Hobby:       "Fashion",
+			Catchphrase: "chicken",
+		},
+		{
+			VillagerID:  "quillson",
+			Name:        "Quillson",
+			Icon:        "/static/images/villagers/icons/quillson.png",
+			Species:     "Duck",
+			Personality: "Smug",
+			BirthMonth:  12,
+			BirthDay:    22,
+			Hobby:       "Music",
+			Catchphrase: "ridukulous",
+		},
+		{
+			VillagerID:  "quinn",
+			Name:        "Quinn",
+			Icon:        "/static/images/villagers/icons/quinn.png",
+			Species:     "Eagle",
+			Personality: "Normal",
+			BirthMonth:  6,
+			BirthDay:    24,
+			Hobby:       "Nature",
+			Catchphrase: "quirk",
+		},
+		{
+			VillagerID:  "raddle",
+			Name:        "Raddle",
+			Icon:        "/static/images/villagers/icons/raddle.png",
+			Species:     "Frog",
+			Personality: "Lazy",
+			BirthMonth:  6,
+			BirthDay:    6,
+			Hobby:       "Play",
+			Catchphrase: "aaach",
+		},
+		{
+			VillagerID:  "rasher",
+			Name:        "Rasher",
+			Icon:        "/static/images/villagers/icons/rasher.png",
+			Species:     "Pig",
+			Personality: "Cranky",
+			BirthMonth:  4,
+			BirthDay:    7,
+			Hobby:       "Nature",
+			Catchphrase: "swine",
+		},
+		{
+			VillagerID:  "raymond",
+			Name:        "Raymond",
+			Icon:        "/static/images/villagers/icons/raymond.png",
+			Species:     "Cat",
+			Personality: "Smug",
+			BirthMonth:  10,
+			BirthDay:    1,
+			Hobby:       "Nature",
+			Catchphrase: "crisp",
+		},
+		{
+			VillagerID:  "renee",
+			Name:        "Renée",
+			Icon:        "/static/images/villagers/icons/renee.png",
+			Species:     "Rhino",
+			Personality: "Sisterly",
+			BirthMonth:  5,
+			BirthDay:    28,
+			Hobby:       "Music",
+			Catchphrase: "yo yo yo",
+		},
+		{
+			VillagerID:  "reneigh",
+			Name:        "Reneigh",
+			Icon:        "/static/images/villagers/icons/reneigh.png",
+			Species:     "Horse",
+			Personality: "Sisterly",
+			BirthMonth:  6,
+			BirthDay:    4,
+			Hobby:       "Music",
+			Catchphrase: "ayup",
+		},
+		{
+			VillagerID:  "rex",
+			Name:        "Rex",
+			Icon:        "/static/images/villagers/icons/rex.png",
+			Species:     "Lion",
+			Personality: "Lazy",
+			BirthMonth:  7,
+			BirthDay:    24,
+			Hobby:       "Play",
+			Catchphrase: "cool cat",
+		},
+		{
+			VillagerID:  "rhonda",
+			Name:        "Rhonda",
+			Icon:        "/static/images/villagers/icons/rhonda.png",
+			Species:     "Rhino",
+			Personality: "Normal",
+			BirthMonth:  1,
+			BirthDay:    24,
+			Hobby:       "Nature",
+			Catchphrase: "bigfoot",
+		},
+		{
+			VillagerID:  "ribbot",
+			Name:        "Ribbot",
+			Icon:        "/static/images/villagers/icons/ribbot.png",
+			Species:     "Frog",
+			Personality: "Jock",
+			BirthMonth:  2,
+			BirthDay:    13,
+			Hobby:       "Fitness",
+			Catchphrase: "zzrrbbitt",
+		},
+		{
+			VillagerID:  "ricky",
+			Name:        "Ricky",
+			Icon:        "/static/images/villagers/icons/ricky.png",
+			Species:     "Squirrel",
+			Personality: "Cranky",
+			BirthMonth:  9,
+			BirthDay:    14,
+			Hobby:       "Nature",
+			Catchphrase: "nutcase",
+		},
+		{
+			VillagerID:  "rilla",
+			Name:        "Rilla",
+			Icon:        "/static/images/villagers/icons/rilla.png",
+			Species:     "Gorilla",
+			Personality: "Peppy",
+			BirthMonth:  11,
+			BirthDay:    2,
+			Hobby:       "Fashion",
+			Catchphrase: "hoo hoo",
+		},
+		{
+			VillagerID:  "rio",
+			Name:        "Rio",
+			Icon:        "/static/images/villagers/icons/rio.png",
+			Species:     "Ostrich",
+			Personality: "Peppy",
+			BirthMonth:  3,
+			BirthDay:    3,
+			Hobby:       "Fitness",
+			Catchphrase: "lalala",
+		},
+		{
+			VillagerID:  "rizzo",
+			Name:        "Rizzo",
+			Icon:        "/static/images/villagers/icons/rizzo.png",
+			Species:     "Mouse",
+			Personality: "Cranky",
+			BirthMonth:  1,
+			BirthDay:    17,
+			Hobby:       "Education",
+			Catchphrase: "squee",
+		},
+		{
+			VillagerID:  "roald",
+			Name:        "Roald",
+			Icon:        "/static/images/villagers/icons/roald.png",
+			Species:     "Penguin",
+			Personality: "Jock",
+			BirthMonth:  1,
+			BirthDay:    5,
+			Hobby:       "Fitness",
+			Catchphrase: "b-b-buddy",
+		},
+		{
+			VillagerID:  "robin",
+			Name:        "Robin",
+			Icon:        "/static/images/villagers/icons/robin.png",
+			Species:     "Bird",
+			Personality: "Snooty",
+			BirthMonth:  12,
+			BirthDay:    4,
+			Hobby:       "Fashion",
+			Catchphrase: "la-di-da",
+		},
+		{
+			VillagerID:  "rocco",
+			Name:        "Rocco",
+			Icon:        "/static/images/villagers/icons/rocco.png",
+			Species:     "Hippo",
+			Personality: "Cranky",
+			BirthMonth:  8,
+			BirthDay:    18,
+			Hobby:       "Nature",
+			Catchphrase: "hippie",
+		},
+		{
+			VillagerID:  "rocket",
+			Name:        "Rocket",
+			Icon:        "/static/images/villagers/icons/rocket.png",
+			Species:     "Gorilla",
+			Personality: "Sisterly",
+			BirthMonth:  4,
+			BirthDay:    14,
+			Hobby:       "Fitness",
+			Catchphrase: "vroom",
+		},
+		{
+			VillagerID:  "rod",
+			Name:        "Rod",
+			Icon:        "/static/images/villagers/icons/rod.png",
+			Species:     "Mouse",
+			Personality: "Jock",
+			BirthMonth:  8,
+			BirthDay:    14,
+			Hobby:       "Fitness",
+			Catchphrase: "ace",
+		},
+		{
+			VillagerID:  "rodeo",
+			Name:        "Rodeo",
+			Icon:        "/static/images/villagers/icons/rodeo.png",
+			Species:     "Bull",
+			Personality: "Lazy",
+			BirthMonth:  10,
+			BirthDay:    29,
+			Hobby:       "Play",
+			Catchphrase: "chaps",
+		},
+		{
+			VillagerID:  "rodney",
+			Name:        "Rodney",
+			Icon:        "/static/images/villagers/icons/rodney.png",
+			Species:     "Hamster",
+			Personality: "Smug",
+			BirthMonth:  11,
+			BirthDay:    10,
+			Hobby:       "Music",
+			Catchphrase: "le ham",
+		},
+		{
+			VillagerID:  "rolf",
+			Name:        "Rolf",
+			Icon:        "/static/images/villagers/icons/rolf.png",
+			Species:     "Tiger",
+			Personality: "Cranky",
+			BirthMonth:  8,
+			BirthDay:    22,
+			Hobby:       "Nature",
+			Catchphrase: "grrrolf",
+		},
+		{
+			VillagerID:  "rooney",
+			Name:        "Rooney",
+			Icon:        "/static/images/villagers/icons/rooney.png",
+			Species:     "Kangaroo",
+			Personality: "Cranky",
+			BirthMonth:  12,
+			BirthDay:    1,
+			Hobby:       "Fitness",
+			Catchphrase: "punches",
+		},
+		{
+			VillagerID:  "rory",
+			Name:        "Rory",
+			Icon:        "/static/images/villagers/icons/rory.png",
+			Species:     "Lion",
+			Personality: "Jock",
+			BirthMonth:  8,
+			BirthDay:    7,
+			Hobby:       "Fitness",
+			Catchphrase: "capital",
+		},
+		{
+			VillagerID:  "roscoe",
+			Name:        "Roscoe",
+			Icon:        "/static/images/villagers/icons/roscoe.png",
+			Species:     "Horse",
+			Personality: "Cranky",
+			BirthMonth:  6,
+			BirthDay:    16,
+			Hobby:       "Music",
+			Catchphrase: "nay",
+		},
+		{
+			VillagerID:  "rosie",
+			Name:        "Rosie",
+			Icon:        "/static/images/villagers/icons/rosie.png",
+			Species:     "Cat",
+			Personality: "Peppy",
+			BirthMonth:  2,
+			BirthDay:    27,
+			Hobby:       "Fashion",
+			Catchphrase: "silly",
+		},
+		{
+			VillagerID:  "roswell",
+			Name:        "Roswell",
+			Icon:        "/static/images/villagers/icons/roswell.png",
+			Species:     "Alligator",
+			Personality: "Smug",
+			BirthMonth:  6,
+			BirthDay:    1,
+			Hobby:       "Nature",
+			Catchphrase: "grumble",
+		},
+		{
+			VillagerID:  "rowan",
+			Name:        "Rowan",
+			Icon:        "/static/images/villagers/icons/rowan.png",
+			Species:     "Tiger",
+			Personality: "Jock",
+			BirthMonth:  8,
+			BirthDay:    26,
+			Hobby:       "Fitness",
+			Catchphrase: "mango",
+		},
+		{
+			VillagerID:  "ruby",
+			Name:        "Ruby",
+			Icon:        "/static/images/villagers/icons/ruby.png",
+			Species:     "Rabbit",
+			Personality: "Peppy",
+			BirthMonth:  12,
+			BirthDay:    25,
+			Hobby:       "Fashion",
+			Catchphrase: "li'l ears",
+		},
+		{
+			VillagerID:  "rudy",
+			Name:        "Rudy",
+			Icon:        "/static/images/villagers/icons/rudy.png",
+			Species:     "Cat",
+			Personality: "Jock",
+			BirthMonth:  12,
+			BirthDay:    20,
+			Hobby:       "Fitness",
+			Catchphrase: "mush",
+		},
+		{
+			VillagerID:  "sally",
+			Name:        "Sally",
+			Icon:        "/static/images/villagers/icons/sally.png",
+			Species:     "Squirrel",
+			Personality: "Normal",
+			BirthMonth:  6,
+			BirthDay:    19,
+			Hobby:       "Nature",
+			Catchphrase: "nutmeg",
+		},
+		{
+			VillagerID:  "samson",
+			Name:        "Samson",
+			Icon:        "/static/images/villagers/icons/samson.png",
+			Species:     "Mouse",
+			Personality: "Jock",
+			BirthMonth:  7,
+			BirthDay:    5,
+			Hobby:       "Fitness",
+			Catchphrase: "pipsqueak",
+		},
+		{
+			VillagerID:  "sandy",
+			Name:        "Sandy",
+			Icon:        "/static/images/villagers/icons/sandy.png",
+			Species:     "Ostrich",
+			Personality: "Normal",
+			BirthMonth:  10,
+			BirthDay:    21,
+			Hobby:       "Nature",
+			Catchphrase: "speedy",
+		},
+		{
+			VillagerID:  "sasha",
+			Name:        "Sasha",
+			Icon:        "/static/images/villagers/icons/sasha.png",
+			Species:     "Rabbit",
+			Personality: "Lazy",
+			BirthMonth:  5,
+			BirthDay:    19,
+			Hobby:       "Fashion",
+			Catchphrase: "hoppity",
+		},
+		{
+			VillagerID:  "savannah",
+			Name:        "Savannah",
+			Icon:        "/static/images/villagers/icons/savannah.png",
+			Species:     "Horse",
+			Personality: "Normal",
+			BirthMonth:  1,
+			BirthDay:    25,
+			Hobby:       "Nature",
+			Catchphrase: "y'all",
+		},
+		{
+			VillagerID:  "scoot",
+			Name:        "Scoot",
+			Icon:        "/static/images/villagers/icons/scoot.png",
+			Species:     "Duck",
+			Personality: "Jock",
+			BirthMonth:  6,
+			BirthDay:    13,
+			Hobby:       "Fitness",
+			Catchphrase: "zip zoom",
+		},
+		{
+			VillagerID:  "shari",
+			Name:        "Shari",
+			Icon:        "/static/images/villagers/icons/shari.png",
+			Species:     "Monkey",
+			Personality: "Sisterly",
+			BirthMonth:  4,
+			BirthDay:    10,
+			Hobby:       "Music",
+			Catchphrase: "cheeky",
+		},
+		{
+			VillagerID:  "sheldon",
+			Name:        "Sheldon",
+			Icon:        "/static/images/villagers/icons/sheldon.png",
+			Species:     "Squirrel",
+			Personality: "Jock",
+			BirthMonth:  2,
+			BirthDay:    26,
+			Hobby:       "Fitness",
+			Catchphrase: "cardio",
+		},
+		{
+			VillagerID:  "shep",
+			Name:        "Shep",
+			Icon:        "/static/images/villagers/icons/shep.png",
+			Species:     "Dog",
+			Personality: "Smug",
+			BirthMonth:  11,
+			BirthDay:    24,
+			Hobby:       "Music",
+			Catchphrase: "baaaffo",
+		},
+		{
+			VillagerID:  "sherb",
+			Name:        "Sherb",
+			Icon:        "/static/images/villagers/icons/sherb.png",
+			Species:     "Goat",
+			Personality: "Lazy",
+			BirthMonth:  1,
+			BirthDay:    18,
+			Hobby:       "Nature",
+			Catchphrase: "bawwww",
+		},
+		{
+			VillagerID:  "shino",
+			Name:        "Shino",
+			Icon:        "/static/images/villagers/icons/shino.png",
+			Species:     "Deer",
+			Personality: "Peppy",
+			BirthMonth:  10,
+			BirthDay:    31,
+			Hobby:       "Fashion",
+			Catchphrase: "okaaay",
+		},
+		{
+			VillagerID:  "simon",
+			Name:        "Simon",
+			Icon:        "/static/images/villagers/icons/simon.png",
+			Species:     "Monkey",
+			Personality: "Lazy",
+			BirthMonth:  1,
+			BirthDay:    19,
+			Hobby:       "Play",
+			Catchphrase: "zzzook",
+		},
+		{
+			VillagerID:  "skye",
+			Name:        "Skye",
+			Icon:        "/static/images/villagers/icons/skye.png",
+			Species:     "Wolf",
+			Personality: "Normal",
+			BirthMonth:  3,
+			BirthDay:    24,
+			Hobby:       "Nature",
+			Catchphrase: "airmail",
+		},
+		{
+			VillagerID:  "sly",
+			Name:        "Sly",
+			Icon:        "/static/images/villagers/icons/sly.png",
+			Species:     "Alligator",
+			Personality: "Jock",
+			BirthMonth:  11,
+			BirthDay:    15,
+			Hobby:       "Fitness",
+			Catchphrase: "hoo-rah",
+		},
+		{
+			VillagerID:  "snake",
+			Name:        "Snake",
+			Icon:        "/static/images/villagers/icons/snake.png",
+			Species:     "Rabbit",
+			Personality: "Jock",
+			BirthMonth:  1,
+			BirthDay:    3,
+			Hobby:       "Fitness",
+			Catchphrase: "bunyip",
+		},
+		{
+			VillagerID:  "snooty",
+			Name:        "Snooty",
+			Icon:        "/static/images/villagers/icons/snooty.png",
+			Species:     "Anteater",
+			Personality: "Snooty",
+			BirthMonth:  10,
+			BirthDay:    24,
+			Hobby:       "Fashion",
+			Catchphrase: "snifff",
+		},
+		{
+			VillagerID:  "soleil",
+			Name:        "Soleil",
+			Icon:        "/static/images/villagers/icons/soleil.png",
+			Species:     "Hamster",
+			Personality: "Snooty",
+			BirthMonth:  8,
+			BirthDay:    9,
+			Hobby:       "Fashion",
+			Catchphrase: "tarnation",
+		},
+		{
+			VillagerID:  "sparro",
+			Name:        "Sparro",
+			Icon:        "/static/images/villagers/icons/sparro.png",
+			Species:     "Bird",
+			Personality: "Jock",
+			BirthMonth:  11,
+			BirthDay:    20,
+			Hobby:       "Fitness",
+			Catchphrase: "like whoa",
+		},
+		{
+			VillagerID:  "spike",
+			Name:        "Spike",
+			Icon:        "/static/images/villagers/icons/spike.png",
+			Species:     "Rhino",
+			Personality: "Cranky",
+			BirthMonth:  6,
+			BirthDay:    17,
+			Hobby:       "Fitness",
+			Catchphrase: "punk",
+		},
+		{
+			VillagerID:  "spork",
+			Name:        "Spork",
+			Icon:        "/static/images/villagers/icons/spork.png",
+			Species:     "Pig",
+			Personality: "Lazy",
+			BirthMonth:  9,
+			BirthDay:    15,
+			Hobby:       "Play",
+			Catchphrase: "snork",
+		},
+		{
+			VillagerID:  "sprinkle",
+			Name:        "Sprinkle",
+			Icon:        "/static/images/villagers/icons/sprinkle.png",
+			Species:     "Penguin",
+			Personality: "Peppy",
+			BirthMonth:  2,
+			BirthDay:    20,
+			Hobby:       "Fashion",
+			Catchphrase: "frappe",
+		},
+		{
+			VillagerID:  "sprocket",
+			Name:        "Sprocket",
+			Icon:        "/static/images/villagers/icons/sprocket.png",
+			Species:     "Ostrich",
+			Personality: "Jock",
+			BirthMonth:  12,
+			BirthDay:    1,
+			Hobby:       "Fitness",
+			Catchphrase: "zoom",
+		},
+		{
+			VillagerID:  "static",
+			Name:        "Static",
+			Icon:        "/static/images/villagers/icons/static.png",
+			Species:     "Squirrel",
+			Personality: "Cranky",
+			BirthMonth:  7,
+			BirthDay:    9,
+			Hobby:       "Music",
+			Catchphrase: "krzzt",
+		},
+		{
+			VillagerID:  "stella",
+			Name:        "Stella",
+			Icon:        "/static/images/villagers/icons/stella.png",
+			Species:     "Sheep",
+			Personality: "Normal",
+			BirthMonth:  4,
+			BirthDay:    9,
+			Hobby:       "Nature",
+			Catchphrase: "baa-dabing",
+		},
+		{
+			VillagerID:  "sterling",
+			Name:        "Sterling",
+			Icon:        "/static/images/villagers/icons/sterling.png",
+			Species:     "Eagle",
+			Personality: "Jock",
+			BirthMonth:  12,
+			BirthDay:    11,
+			Hobby:       "Fitness",
+			Catchphrase: "skraaaaw",
+		},
+		{
+			VillagerID:  "stinky",
+			Name:        "Stinky",
+			Icon:        "/static/images/villagers/icons/stinky.png",
+			Species:     "Cat",
+			Personality: "Jock",
+			BirthMonth:  8,
+			BirthDay:    17,
+			Hobby:       "Fitness",
+			Catchphrase: "GAHOO",
+		},
+		{
+			VillagerID:  "stitches",
+			Name:        "Stitches",
+			Icon:        "/static/images/villagers/icons/stitches.png",
+			Species:     "Cub",
+			Personality: "Lazy",
+			BirthMonth:  2,
+			BirthDay:    10,
+			Hobby:       "Play",
+			Catchphrase: "stuffin'",
+		},
+		{
+			VillagerID:  "stu",
+			Name:        "Stu",
+			Icon:        "/static/images/villagers/icons/stu.png",
+			Species:     "Bull",
+			Personality: "Lazy",
+			BirthMonth:  4,
+			BirthDay:    20,
+			Hobby:       "Play",
+			Catchphrase: "moo-dude",
+		},
+		{
+			VillagerID:  "sydney",
+			Name:        "Sydney",
+			Icon:        "/static/images/villagers/icons/sydney.png",
+			Species:     "Koala",
+			Personality: "Normal",
+			BirthMonth:  6,
+			BirthDay:    21,
+			Hobby:       "Nature",
+			Catchphrase: "sunshine",
+		},
+		{
+			VillagerID:  "sylvana",
+			Name:        "Sylvana",
+			Icon:        "/static/images/villagers/icons/sylvana.png",
+			Species:     "Squirrel",
+			Personality: "Normal",
+			BirthMonth:  12,
+			BirthDay:    22,
+			Hobby:       "Nature",
+			Catchphrase: "hubbub",
+		},
+		{
+			VillagerID:  "sylvia",
+			Name:        "Sylvia",
+			Icon:        "/static/images/villagers/icons/sylvia.png",
+			Species:     "Kangaroo",
+			Personality: "Sisterly",
+			BirthMonth:  5,
+			BirthDay:    3,
+			Hobby:       "Fitness",
+			Catchphrase: "boing",
+		},
+		{
+			VillagerID:  "t-bone",
+			Name:        "T-Bone",
+			Icon:        "/static/images/villagers/icons/t-bone.png",
+			Species:     "Bull",
+			Personality: "Cranky",
+			BirthMonth:  5,
+			BirthDay:    20,
+			Hobby:       "Fitness",
+			Catchphrase: "moocher",
+		},
+		{
+			VillagerID:  "tabby",
+			Name:        "Tabby",
+			Icon:        "/static/images/villagers/icons/tabby.png",
+			Species:     "Cat",
+			Personality: "Peppy",
+			BirthMonth:  8,
+			BirthDay:    13,
+			Hobby:       "Fashion",
+			Catchphrase: "me-WOW",
+		},
+		{
+			VillagerID:  "tad",
+			Name:        "Tad",
+			Icon:        "/static/images/villagers/icons/tad.png",
+			Species:     "Frog",
+			Personality: "Jock",
+			BirthMonth:  8,
+			BirthDay:    3,
+			Hobby:       "Fitness",
+			Catchphrase: "sprinty",
+		},
+		{
+			VillagerID:  "tammi",
+			Name:        "Tammi",
+			Icon:        "/static/images/villagers/icons/tammi.png",
+			Species:     "Monkey",
+			Personality: "Peppy",
+			BirthMonth:  4,
+			BirthDay:    2,
+			Hobby:       "Fitness",
+			Catchphrase: "chimpy",
+		},
+		{
+			VillagerID:  "tammy",
+			Name:        "Tammy",
+			Icon:        "/static/images/villagers/icons/tammy.png",
+			Species:     "Cub",
+			Personality: "Sisterly",
+			BirthMonth:  6,
+			BirthDay:    23,
+			Hobby:       "Music",
+			Catchphrase: "ya heard",
+		},
+		{
+			VillagerID:  "tangy",
+			Name:        "Tangy",
+			Icon:        "/static/images/villagers/icons/tangy.png",
+			Species:     "Cat",
+			Personality: "Peppy",
+			BirthMonth:  6,
+			BirthDay:    17,
+			Hobby:       "Fashion",
+			Catchphrase: "reeeeOWR",
+		},
+		{
+			VillagerID:  "tank",
+			Name:        "Tank",
+			Icon:        "/static/images/villagers/icons/tank.png",
+			Species:     "Rhino",
+			Personality: "Jock",
+			BirthMonth:  5,
+			BirthDay:    6,
+			Hobby:       "Fitness",
+			Catchphrase: "kerPOW",
+		},
+		{
+			VillagerID:  "tasha",
+			Name:        "Tasha",
+			Icon:        "/static/images/villagers/icons/tasha.png",
+			Species:     "Squirrel",
+			Personality: "Snooty",
+			BirthMonth:  11,
+			BirthDay:    30,
+			Hobby:       "Fashion",
+			Catchphrase: "nice nice",
+		},
+		{
+			VillagerID:  "teddy",
+			Name:        "Teddy",
+			Icon:        "/static/images/villagers/icons/teddy.png",
+			Species:     "Bear",
+			Personality: "Jock",
+			BirthMonth:  9,
+			BirthDay:    26,
+			Hobby:       "Fitness",
+			Catchphrase: "grooof",
+		},
+		{
+			VillagerID:  "tex",
+			Name:        "Tex",
+			Icon:        "/static/images/villagers/icons/tex.png",
+			Species:     "Penguin",
+			Personality: "Smug",
+			BirthMonth:  10,
+			BirthDay:    6,
+			Hobby:       "Music",
+			Catchphrase: "picante",
+		},
+		{
+			VillagerID:  "tia",
+			Name:        "Tia",
+			Icon:        "/static/images/villagers/icons/tia.png",
+			Species:     "Elephant",
+			Personality: "Normal",
+			BirthMonth:  11,
+			BirthDay:    18,
+			Hobby:       "Education",
+			Catchphrase: "teacup",
+		},
+		{
+			VillagerID:  "tiansheng",
+			Name:        "Tiansheng",
+			Icon:        "/static/images/villagers/icons/tiansheng.png",
+			Species:     "Monkey",
+			Personality: "Jock",
+			BirthMonth:  1,
+			BirthDay:    1,
+			Hobby:       "Fitness",
+			Catchphrase: "yeehaw",
+		},
+		{
+			VillagerID:  "tiffany",
+			Name:        "Tiffany",
+			Icon:        "/static/images/villagers/icons/tiffany.png",
+			Species:     "Rabbit",
+			Personality: "Snooty",
+			BirthMonth:  1,
+			BirthDay:    9,
+			Hobby:       "Fashion",
+			Catchphrase: "bun bun",
+		},
+		{
+			VillagerID:  "timbra",
+			Name:        "Timbra",
+			Icon:        "/static/images/villagers/icons/timbra.png",
+			Species:     "Sheep",
+			Personality: "Snooty",
+			BirthMonth:  10,
+			BirthDay:    21,
+			Hobby:       "Fashion",
+			Catchphrase: "pastoral",
+		},
+		{
+			VillagerID:  "tipper",
+			Name:        "Tipper",
+			Icon:        "/static/images/villagers/icons/tipper.png",
+			Species:     "Cow",
+			Personality: "Snooty",
+			BirthMonth:  8,
+			BirthDay:    25,
+			Hobby:       "Fashion",
+			Catchphrase: "pushy",
+		},
+		{
+			VillagerID:  "toby",
+			Name:        "Toby",
+			Icon:        "/static/images/villagers/icons/toby.png",
+			Species:     "Rabbit",
+			Personality: "Smug",
+			BirthMonth:  7,
+			BirthDay:    10,
+			Hobby:       "Music",
+			Catchphrase: "cheer up",
+		},
+		{
+			VillagerID:  "tom",
+			Name:        "Tom",
+			Icon:        "/static/images/villagers/icons/tom.png",
+			Species:     "Cat",
+			Personality: "Cranky",
+			BirthMonth:  12,
+			BirthDay:    10,
+			Hobby:       "Nature",
+			Catchphrase: "me-WOW",
+		},
+		{
+			VillagerID:  "truffles",
+			Name:        "Truffles",
+			Icon:        "/static/images/villagers/icons/truffles.png",
+			Species:     "Pig",
+			Personality: "Peppy",
+			BirthMonth:  7,
+			BirthDay:    28,
+			Hobby:       "Fashion",
+			Catchphrase: "snoutie",
+		},
+		{
+			VillagerID:  "tucker",
+			Name:        "Tucker",
+			Icon:        "/static/images/villagers/icons/tucker.png",
+			Species:     "Elephant",
+			Personality: "Lazy",
+			BirthMonth:  9,
+			BirthDay:    7,
+			Hobby:       "Play",
+			Catchphrase: "fuzzers",
+		},
+		{
+			VillagerID:  "tutu",
+			Name:        "Tutu",
+			Icon:        "/static/images/villagers/icons/tutu.png",
+			Species:     "Bear",
+			Personality: "Peppy",
+			BirthMonth:  10,
+			BirthDay:    15,
+			Hobby:       "Fitness",
+			Catchphrase: "twinkletoes",
+		},
+		{
+			VillagerID:  "twiggy",
+			Name:        "Twiggy",
+			Icon:        "/static/images/villagers/icons/twiggy.png",
+			Species:     "Bird",
+			Personality: "Peppy",
+			BirthMonth:  7,
+			BirthDay:    13,
+			Hobby:       "Fashion",
+			Catchphrase: "cheepers",
+		},
+		{
+			VillagerID:  "tybalt",
+			Name:        "Tybalt",
+			Icon:        "/static/images/villagers/icons/tybalt.png",
+			Species:     "Tiger",
+			Personality: "Jock",
+			BirthMonth:  8,
+			BirthDay:    19,
+			Hobby:       "Fitness",
+			Catchphrase: "grrRAH",
+		},
+		{
+			VillagerID:  "ursala",
+			Name:        "Ursala",
+			Icon:        "/static/images/villagers/icons/ursala.png",
+			Species:     "Bear",
+			Personality: "Sisterly",
+			BirthMonth:  1,
+			BirthDay:    16,
+			Hobby:       "Play",
+			Catchphrase: "grooomph",
+		},
+		{
+			VillagerID:  "velma",
+			Name:        "Velma",
+			Icon:        "/static/images/villagers/icons/velma.png",
+			Species:     "Goat",
+			Personality: "Snooty",
+			BirthMonth:  1,
+			BirthDay:    14,
+			Hobby:       "Fashion",
+			Catchphrase: "blih",
+		},
+		{
+			VillagerID:  "vesta",
+			Name:        "Vesta",
+			Icon:        "/static/images/villagers/icons/vesta.png",
+			Species:     "Sheep",
+			Personality: "Normal",
+			BirthMonth:  4,
+			BirthDay:    16,
+			Hobby:       "Nature",
+			Catchphrase: "baaaffo",
+		},
+		{
+			VillagerID:  "vic",
+			Name:        "Vic",
+			Icon:        "/static/images/villagers/icons/vic.png",
+			Species:     "Bull",
+			Personality: "Cranky",
+			BirthMonth:  12,
+			BirthDay:    29,
+			Hobby:       "Fitness",
+			Catchphrase: "cud",
+		},
+		{
+			VillagerID:  "victoria",
+			Name:        "Victoria",
+			Icon:        "/static/images/villagers/icons/victoria.png",
+			Species:     "Horse",
+			Personality: "Peppy",
+			BirthMonth:  7,
+			BirthDay:    11,
+			Hobby:       "Fitness",
+			Catchphrase: "sugar cube",
+		},
+		{
+			VillagerID:  "violet",
+			Name:        "Violet",
+			Icon:        "/static/images/villagers/icons/violet.png",
+			Species:     "Gorilla",
+			Personality: "Snooty",
+			BirthMonth:  9,
+			BirthDay:    1,
+			Hobby:       "Fashion",
+			Catchphrase: "sweetie",
+		},
+		{
+			VillagerID:  "vivian",
+			Name:        "Vivian",
+			Icon:        "/static/images/villagers/icons/vivian.png",
+			Species:     "Wolf",
+			Personality: "Snooty",
+			BirthMonth:  1,
+			BirthDay:    26,
+			Hobby:       "Fashion",
+			Catchphrase: "piffle",
+		},
+		{
+			VillagerID:  "vladimir",
+			Name:        "Vladimir",
+			Icon:        "/static/images/villagers/icons/vladimir.png",
+			Species:     "Cub",
+			Personality: "Cranky",
+			BirthMonth:  8,
+			BirthDay:    2,
+			Hobby:       "Education",
+			Catchphrase: "nyet",
+		},
+		{
+			VillagerID:  "wade",
+			Name:        "Wade",
+			Icon:        "/static/images/villagers/icons/wade.png",
+			Species:     "Penguin",
+			Personality: "Lazy",
+			BirthMonth:  10,
+			BirthDay:    30,
+			Hobby:       "Play",
+			Catchphrase: "so it goes",
+		},
+		{
+			VillagerID:  "walker",
+			Name:        "Walker",
+			Icon:        "/static/images/villagers/icons/walker.png",
+			Species:     "Dog",
+			Personality: "Lazy",
+			BirthMonth:  6,
+			BirthDay:    10,
+			Hobby:       "Play",
+			Catchphrase: "wuh",
+		},
+		{
+			VillagerID:  "walt",
+			Name:        "Walt",
+			Icon:        "/static/images/villagers/icons/walt.png",
+			Species:     "Kangaroo",
+			Personality: "Cranky",
+			BirthMonth:  4,
+			BirthDay:    24,
+			Hobby:       "Nature",
+			Catchphrase: "pockets",
+		},
+		{
+			VillagerID:  "wart-jr",
+			Name:        "Wart Jr.",
+			Icon:        "/static/images/villagers/icons/wart-jr.png",
+			Species:     "Frog",
+			Personality: "Cranky",
+			BirthMonth:  8,
+			BirthDay:    21,
+			Hobby:       "Fitness",
+			Catchphrase: "grr-ribbit",
+		},
+		{
+			VillagerID:  "weber",
+			Name:        "Weber",
+			Icon:        "/static/images/villagers/icons/weber.png",
+			Species:     "Duck",
+			Personality: "Lazy",
+			BirthMonth:  6,
+			BirthDay:    30,
+			Hobby:       "Play",
+			Catchphrase: "quaa",
+		},
+		{
+			VillagerID:  "wendy",
+			Name:        "Wendy",
+			Icon:        "/static/images/villagers/icons/wendy.png",
+			Species:     "Sheep",
+			Personality: "Peppy",
+			BirthMonth:  8,
+			BirthDay:    19,
+			Hobby:       "Fashion",
+			Catchphrase: "lambkins",
+		},
+		{
+			VillagerID:  "whitney",
+			Name:        "Whitney",
+			Icon:        "/static/images/villagers/icons/whitney.png",
+			Species:     "Wolf",
+			Personality: "Snooty",
+			BirthMonth:  9,
+			BirthDay:    17,
+			Hobby:       "Fashion",
+			Catchphrase: "snappy",
+		},
+		{
+			VillagerID:  "willow",
+			Name:        "Willow",
+			Icon:        "/static/images/villagers/icons/willow.png",
+			Species:     "Sheep",
+			Personality: "Snooty",
+			BirthMonth:  11,
+			BirthDay:    26,
+			Hobby:       "Fashion",
+			Catchphrase: "bo peep",
+		},
+		{
+			VillagerID:  "winnie",
+			Name:        "Winnie",
+			Icon:        "/static/images/villagers/icons/winnie.png",
+			Species:     "Horse",
+			Personality: "Peppy",
+			BirthMonth:  1,
+			BirthDay:    31,
+			Hobby:       "Fashion",
+			Catchphrase: "hay-OK",
+		},
+		{
+			VillagerID:  "wolfgang",
+			Name:        "Wolfgang",
+			Icon:        "/static/images/villagers/icons/wolfgang.png",
+			Species:     "Wolf",
+			Personality: "Cranky",
+			BirthMonth:  11,
+			BirthDay:    25,
+			Hobby:       "Music",
+			Catchphrase: "snarrrl",
+		},
+		{
+			VillagerID:  "yuka",
+			Name:        "Yuka",
+			Icon:        "/static/images/villagers/icons/yuka.png",
+			Species:     "Koala",
+			Personality: "Snooty",
+			BirthMonth:  7,
+			BirthDay:    20,
+			Hobby:       "Fashion",
+			Catchphrase: "tsk tsk",
+		},
+		{
+			VillagerID:  "zell",
+			Name:        "Zell",
+			Icon:        "/static/images/villagers/icons/zell.png",
+			Species:     "Deer",
+			Personality: "Smug",
+			BirthMonth:  6,
+			BirthDay:    7,
+			Hobby:       "Music",
+			Catchphrase: "pronk",
+		},
+		{
+			VillagerID:  "zoe",
+			Name:        "Zoe",
+			Icon:        "/static/images/villagers/icons/zoe.png",
+			Species:     "Anteater",
+			Personality: "Normal",
+			BirthMonth:  9,
+			BirthDay:    5,
+			Hobby:       "Education",
+			Catchphrase: "zzzzzzzz",
+		},
+		{
+			VillagerID:  "zucker",
+			Name:        "Zucker",
+			Icon:        "/static/images/villagers/icons/zucker.png",
+			Species:     "Octopus",
+			Personality: "Lazy",
+			BirthMonth:  3,
+			BirthDay:    8,
+			Hobby:       "Nature",
+			Catchphrase: "bloop",
+		},
+	}
+}
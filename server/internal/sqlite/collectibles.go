@@ -0,0 +1,276 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/availability"
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+	"github.com/mcgigglepop/acnh-finder/server/internal/repository"
+)
+
+// SeedCatalog inserts or refreshes the collectible catalog
+func (s *Store) SeedCatalog(ctx context.Context, catalog []models.Collectible) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin seed: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, c := range catalog {
+		north, err := json.Marshal(c.NorthAvailability)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s %s: %w", c.Category, c.ID, err)
+		}
+		south, err := json.Marshal(c.SouthAvailability)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s %s: %w", c.Category, c.ID, err)
+		}
+		attributes, err := json.Marshal(c.Attributes)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s %s: %w", c.Category, c.ID, err)
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO collectibles (category, id, name, icon, sell_price, north_availability, south_availability, attributes)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT (category, id) DO UPDATE SET
+				name = excluded.name,
+				icon = excluded.icon,
+				sell_price = excluded.sell_price,
+				north_availability = excluded.north_availability,
+				south_availability = excluded.south_availability,
+				attributes = excluded.attributes`,
+			c.Category, c.ID, c.Name, c.Icon, c.SellPrice, string(north), string(south), string(attributes),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert %s %s: %w", c.Category, c.ID, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (s *Store) ListAvailable(ctx context.Context, category, userID string, month int, at availability.Clock, hemisphere string) ([]models.Collectible, error) {
+	all, err := s.ListCollectibles(ctx, category, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var available []models.Collectible
+	for _, c := range all {
+		ok, err := availability.Available(availability.Seasons(c, hemisphere), month, at)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check availability of %s %s: %w", category, c.ID, err)
+		}
+		if ok {
+			available = append(available, c)
+		}
+	}
+
+	return available, nil
+}
+
+func (s *Store) ListCollectibles(ctx context.Context, category, userID string) ([]models.Collectible, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT c.category, c.id, c.name, c.icon, c.sell_price,
+		       c.north_availability, c.south_availability, c.attributes,
+		       COALESCE(uc.caught, 0), COALESCE(uc.caught_at, ''),
+		       COALESCE(uc.donated, 0), COALESCE(uc.donated_at, ''),
+		       COALESCE(uc.duplicates, 0)
+		FROM collectibles c
+		LEFT JOIN user_collectibles uc
+		       ON uc.category = c.category AND uc.id = c.id AND uc.user_id = ?
+		WHERE c.category = ?
+		ORDER BY c.rowid`,
+		userID, category,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query %s: %w", category, err)
+	}
+	defer rows.Close()
+
+	var all []models.Collectible
+	for rows.Next() {
+		var c models.Collectible
+		var north, south, attributes, caughtAt, donatedAt string
+		err := rows.Scan(&c.Category, &c.ID, &c.Name, &c.Icon, &c.SellPrice,
+			&north, &south, &attributes, &c.Caught, &caughtAt, &c.Donated, &donatedAt, &c.Duplicates)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan %s: %w", category, err)
+		}
+
+		if c.CaughtAt, err = parseTime(caughtAt); err != nil {
+			return nil, fmt.Errorf("failed to decode %s %s: %w", category, c.ID, err)
+		}
+		if c.DonatedAt, err = parseTime(donatedAt); err != nil {
+			return nil, fmt.Errorf("failed to decode %s %s: %w", category, c.ID, err)
+		}
+
+		if err := decodeCatalogColumns(&c, north, south, attributes); err != nil {
+			return nil, err
+		}
+
+		all = append(all, c)
+	}
+
+	return all, rows.Err()
+}
+
+// decodeCatalogColumns fills in the JSON encoded columns of a catalog row
+func decodeCatalogColumns(c *models.Collectible, north, south, attributes string) error {
+	if err := json.Unmarshal([]byte(north), &c.NorthAvailability); err != nil {
+		return fmt.Errorf("failed to decode %s %s: %w", c.Category, c.ID, err)
+	}
+	if err := json.Unmarshal([]byte(south), &c.SouthAvailability); err != nil {
+		return fmt.Errorf("failed to decode %s %s: %w", c.Category, c.ID, err)
+	}
+	if err := json.Unmarshal([]byte(attributes), &c.Attributes); err != nil {
+		return fmt.Errorf("failed to decode %s %s: %w", c.Category, c.ID, err)
+	}
+	return nil
+}
+
+func (s *Store) CountCollectibles(ctx context.Context, category string) (int, error) {
+	var count int
+	err := s.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM collectibles WHERE category = ?`,
+		category,
+	).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count %s: %w", category, err)
+	}
+	return count, nil
+}
+
+func (s *Store) GetCollectible(ctx context.Context, category, id string) (*models.Collectible, error) {
+	var c models.Collectible
+	var north, south, attributes string
+	err := s.db.QueryRowContext(ctx, `
+		SELECT category, id, name, icon, sell_price, north_availability, south_availability, attributes
+		FROM collectibles
+		WHERE category = ? AND id = ?`,
+		category, id,
+	).Scan(&c.Category, &c.ID, &c.Name, &c.Icon, &c.SellPrice, &north, &south, &attributes)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s %s: %w", category, id, err)
+	}
+
+	if err := decodeCatalogColumns(&c, north, south, attributes); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (s *Store) PutCaught(ctx context.Context, userID, category, id string) error {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO user_collectibles (user_id, category, id, caught, caught_at) VALUES (?, ?, ?, 1, ?)
+		ON CONFLICT (user_id, category, id) DO NOTHING`,
+		userID, category, id, formatTime(time.Now()),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert caught %s: %w", category, err)
+	}
+	return nil
+}
+
+func (s *Store) DeleteCaught(ctx context.Context, userID, category, id string) error {
+	_, err := s.db.ExecContext(ctx,
+		`DELETE FROM user_collectibles WHERE user_id = ? AND category = ? AND id = ?`,
+		userID, category, id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete caught %s: %w", category, err)
+	}
+	return nil
+}
+
+func (s *Store) PutDonated(ctx context.Context, userID, category, id string) error {
+	now := formatTime(time.Now())
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO user_collectibles (user_id, category, id, caught, caught_at, donated, donated_at)
+		VALUES (?, ?, ?, 1, ?, 1, ?)
+		ON CONFLICT (user_id, category, id) DO UPDATE SET
+			donated = 1,
+			donated_at = CASE WHEN donated = 1 THEN donated_at ELSE excluded.donated_at END`,
+		userID, category, id, now, now,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert donated %s: %w", category, err)
+	}
+	return nil
+}
+
+func (s *Store) DeleteDonated(ctx context.Context, userID, category, id string) error {
+	_, err := s.db.ExecContext(ctx,
+		`UPDATE user_collectibles SET donated = 0, donated_at = '' WHERE user_id = ? AND category = ? AND id = ?`,
+		userID, category, id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete donated %s: %w", category, err)
+	}
+	return nil
+}
+
+func (s *Store) CountCaught(ctx context.Context, userID, category string) (int, error) {
+	var count int
+	err := s.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM user_collectibles WHERE user_id = ? AND category = ?`,
+		userID, category,
+	).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count caught %s: %w", category, err)
+	}
+	return count, nil
+}
+
+func (s *Store) CountDonated(ctx context.Context, userID, category string) (int, error) {
+	var count int
+	err := s.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM user_collectibles WHERE user_id = ? AND category = ? AND donated = 1`,
+		userID, category,
+	).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count donated %s: %w", category, err)
+	}
+	return count, nil
+}
+
+func (s *Store) SetDuplicates(ctx context.Context, userID, category, id string, count int) error {
+	var err error
+	if count <= 0 {
+		_, err = s.db.ExecContext(ctx,
+			`UPDATE user_collectibles SET duplicates = 0 WHERE user_id = ? AND category = ? AND id = ?`,
+			userID, category, id,
+		)
+	} else {
+		_, err = s.db.ExecContext(ctx, `
+			INSERT INTO user_collectibles (user_id, category, id, caught, caught_at, duplicates) VALUES (?, ?, ?, 1, ?, ?)
+			ON CONFLICT (user_id, category, id) DO UPDATE SET duplicates = excluded.duplicates`,
+			userID, category, id, formatTime(time.Now()), count,
+		)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set duplicate %s: %w", category, err)
+	}
+	return nil
+}
+
+func (s *Store) CountDuplicates(ctx context.Context, userID, category string) (int, error) {
+	var count int
+	err := s.db.QueryRowContext(ctx,
+		`SELECT COALESCE(SUM(duplicates), 0) FROM user_collectibles WHERE user_id = ? AND category = ?`,
+		userID, category,
+	).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count duplicate %s: %w", category, err)
+	}
+	return count, nil
+}
@@ -0,0 +1,209 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/availability"
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+	"github.com/mcgigglepop/acnh-finder/server/internal/repository"
+)
+
+// ids returns the IDs of the collectibles, in order
+func ids(collectibles []models.Collectible) []string {
+	var ids []string
+	for _, c := range collectibles {
+		ids = append(ids, c.ID)
+	}
+	return ids
+}
+
+func TestCatalog(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s store) {
+		ctx := context.Background()
+
+		fish, err := s.ListCollectibles(ctx, models.CategoryFish, "user-1")
+		if err != nil {
+			t.Fatalf("ListCollectibles: %v", err)
+		}
+		// Listed in seed order, with every column intact
+		if !reflect.DeepEqual(fish, testCatalog[:2]) {
+			t.Errorf("ListCollectibles = %+v, want %+v", fish, testCatalog[:2])
+		}
+
+		for category, want := range map[string]int{models.CategoryFish: 2, models.CategoryFossil: 1, models.CategoryBug: 0} {
+			got, err := s.CountCollectibles(ctx, category)
+			if err != nil {
+				t.Fatalf("CountCollectibles: %v", err)
+			}
+			if got != want {
+				t.Errorf("CountCollectibles(%s) = %d, want %d", category, got, want)
+			}
+		}
+
+		amber, err := s.GetCollectible(ctx, models.CategoryFossil, "amber")
+		if err != nil {
+			t.Fatalf("GetCollectible: %v", err)
+		}
+		if !reflect.DeepEqual(*amber, testCatalog[2]) {
+			t.Errorf("GetCollectible = %+v, want %+v", *amber, testCatalog[2])
+		}
+
+		missing := []struct{ category, id string }{
+			{models.CategoryFossil, "no-such-fossil"},
+			{models.CategoryFish, "amber"}, // right ID, wrong category
+		}
+		for _, m := range missing {
+			if _, err := s.GetCollectible(ctx, m.category, m.id); !errors.Is(err, repository.ErrNotFound) {
+				t.Errorf("GetCollectible(%s, %s) err = %v, want ErrNotFound", m.category, m.id, err)
+			}
+		}
+
+		available := []struct {
+			month      int
+			at         availability.Clock
+			hemisphere string
+			want       []string
+		}{
+			{1, 8 * 60, "north", []string{"bitterling"}},
+			{1, 12 * 60, "north", []string{"bitterling", "pale-chub"}},
+			{1, 12 * 60, "south", []string{"pale-chub"}},
+			{6, 20 * 60, "north", nil},
+		}
+		for _, a := range available {
+			got, err := s.ListAvailable(ctx, models.CategoryFish, "user-1", a.month, a.at, a.hemisphere)
+			if err != nil {
+				t.Fatalf("ListAvailable: %v", err)
+			}
+			if !reflect.DeepEqual(ids(got), a.want) {
+				t.Errorf("ListAvailable in month %d at %s in the %s = %v, want %v", a.month, a.at, a.hemisphere, ids(got), a.want)
+			}
+		}
+	})
+}
+
+// progress returns the user's progress on the collectible as listed
+func progress(t *testing.T, s store, userID, category, id string) models.Collectible {
+	t.Helper()
+	all, err := s.ListCollectibles(context.Background(), category, userID)
+	if err != nil {
+		t.Fatalf("ListCollectibles: %v", err)
+	}
+	for _, c := range all {
+		if c.ID == id {
+			return c
+		}
+	}
+	t.Fatalf("%s %s not listed", category, id)
+	return models.Collectible{}
+}
+
+// counts returns the user's caught, donated and duplicate counts
+func counts(t *testing.T, s store, userID, category string) [3]int {
+	t.Helper()
+	ctx := context.Background()
+	caught, err := s.CountCaught(ctx, userID, category)
+	if err != nil {
+		t.Fatalf("CountCaught: %v", err)
+	}
+	donated, err := s.CountDonated(ctx, userID, category)
+	if err != nil {
+		t.Fatalf("CountDonated: %v", err)
+	}
+	duplicates, err := s.CountDuplicates(ctx, userID, category)
+	if err != nil {
+		t.Fatalf("CountDuplicates: %v", err)
+	}
+	return [3]int{caught, donated, duplicates}
+}
+
+func TestCollection(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s store) {
+		ctx := context.Background()
+		fish := models.CategoryFish
+
+		if err := s.PutCaught(ctx, "user-1", fish, "bitterling"); err != nil {
+			t.Fatalf("PutCaught: %v", err)
+		}
+		first := progress(t, s, "user-1", fish, "bitterling")
+		if !first.Caught || first.CaughtAt.IsZero() || first.Donated {
+			t.Fatalf("after PutCaught = %+v, want caught with a time and not donated", first)
+		}
+
+		// Catching again keeps the first catch time
+		if err := s.PutCaught(ctx, "user-1", fish, "bitterling"); err != nil {
+			t.Fatalf("PutCaught again: %v", err)
+		}
+		if again := progress(t, s, "user-1", fish, "bitterling"); !again.CaughtAt.Equal(first.CaughtAt) {
+			t.Errorf("caught_at moved from %v to %v", first.CaughtAt, again.CaughtAt)
+		}
+
+		// Donating marks uncaught entries caught too
+		if err := s.PutDonated(ctx, "user-1", fish, "pale-chub"); err != nil {
+			t.Fatalf("PutDonated: %v", err)
+		}
+		if c := progress(t, s, "user-1", fish, "pale-chub"); !c.Caught || !c.Donated || c.DonatedAt.IsZero() {
+			t.Errorf("after PutDonated = %+v, want caught and donated", c)
+		}
+		if got, want := counts(t, s, "user-1", fish), [3]int{2, 1, 0}; got != want {
+			t.Errorf("counts = %v, want %v", got, want)
+		}
+
+		// Taking a donation back keeps the catch
+		if err := s.DeleteDonated(ctx, "user-1", fish, "pale-chub"); err != nil {
+			t.Fatalf("DeleteDonated: %v", err)
+		}
+		if c := progress(t, s, "user-1", fish, "pale-chub"); !c.Caught || c.Donated || !c.DonatedAt.IsZero() {
+			t.Errorf("after DeleteDonated = %+v, want caught and not donated", c)
+		}
+
+		// Unmarking a catch drops the record
+		if err := s.DeleteCaught(ctx, "user-1", fish, "pale-chub"); err != nil {
+			t.Fatalf("DeleteCaught: %v", err)
+		}
+		if c := progress(t, s, "user-1", fish, "pale-chub"); c.Caught || !c.CaughtAt.IsZero() {
+			t.Errorf("after DeleteCaught = %+v, want not caught", c)
+		}
+		if got, want := counts(t, s, "user-1", fish), [3]int{1, 0, 0}; got != want {
+			t.Errorf("counts = %v, want %v", got, want)
+		}
+
+		// Removing what isn't there is not an error
+		if err := s.DeleteCaught(ctx, "user-1", fish, "pale-chub"); err != nil {
+			t.Errorf("DeleteCaught of an uncaught entry: %v", err)
+		}
+		if err := s.DeleteDonated(ctx, "user-1", fish, "pale-chub"); err != nil {
+			t.Errorf("DeleteDonated of an undonated entry: %v", err)
+		}
+
+		fossil := models.CategoryFossil
+		if err := s.SetDuplicates(ctx, "user-1", fossil, "amber", 3); err != nil {
+			t.Fatalf("SetDuplicates: %v", err)
+		}
+		if c := progress(t, s, "user-1", fossil, "amber"); !c.Caught || c.Duplicates != 3 {
+			t.Errorf("after SetDuplicates = %+v, want assessed with 3 duplicates", c)
+		}
+		if got, want := counts(t, s, "user-1", fossil), [3]int{1, 0, 3}; got != want {
+			t.Errorf("fossil counts = %v, want %v", got, want)
+		}
+		if err := s.SetDuplicates(ctx, "user-1", fossil, "amber", 0); err != nil {
+			t.Fatalf("SetDuplicates to 0: %v", err)
+		}
+		if got, want := counts(t, s, "user-1", fossil), [3]int{1, 0, 0}; got != want {
+			t.Errorf("fossil counts after clearing duplicates = %v, want %v", got, want)
+		}
+
+		// Progress is per user and per category
+		if got, want := counts(t, s, "user-2", fish), [3]int{0, 0, 0}; got != want {
+			t.Errorf("user-2 counts = %v, want %v", got, want)
+		}
+		if c := progress(t, s, "user-2", fish, "bitterling"); c.Caught {
+			t.Errorf("user-2 sees user-1's catch")
+		}
+		if got, want := counts(t, s, "user-1", models.CategoryBug), [3]int{0, 0, 0}; got != want {
+			t.Errorf("bug counts = %v, want %v", got, want)
+		}
+	})
+}
@@ -0,0 +1,120 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+	"github.com/mcgigglepop/acnh-finder/server/internal/repository"
+)
+
+// formatTime stores a time as RFC3339, or an empty string for the zero time
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// parseTime is the inverse of formatTime
+func parseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
+// formatPreciseTime is formatTime to the nanosecond, for times that are
+// compared within the second rather than ordered in SQL
+func formatPreciseTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// GetCredential looks up a local account by email
+func (s *Store) GetCredential(ctx context.Context, email string) (*models.Credential, error) {
+	var cred models.Credential
+	var expiresAt, resetExpiresAt, validAfter string
+	err := s.db.QueryRowContext(ctx, `
+		SELECT sub, email, password_hash, confirmed, confirmation_code, code_expires_at, code_attempts,
+		       reset_code, reset_code_expires_at, reset_attempts, tokens_valid_after
+		FROM credentials WHERE email = ?`, email,
+	).Scan(&cred.Sub, &cred.Email, &cred.PasswordHash, &cred.Confirmed, &cred.ConfirmationCode, &expiresAt, &cred.CodeAttempts,
+		&cred.ResetCode, &resetExpiresAt, &cred.ResetAttempts, &validAfter)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get credential: %w", err)
+	}
+
+	if cred.CodeExpiresAt, err = parseTime(expiresAt); err != nil {
+		return nil, fmt.Errorf("failed to parse code expiry: %w", err)
+	}
+	if cred.ResetExpiresAt, err = parseTime(resetExpiresAt); err != nil {
+		return nil, fmt.Errorf("failed to parse reset code expiry: %w", err)
+	}
+	if cred.TokensValidAfter, err = parseTime(validAfter); err != nil {
+		return nil, fmt.Errorf("failed to parse token validity: %w", err)
+	}
+
+	return &cred, nil
+}
+
+// PutCredential creates or replaces a local account
+func (s *Store) PutCredential(ctx context.Context, cred models.Credential) error {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO credentials (sub, email, password_hash, confirmed, confirmation_code, code_expires_at, code_attempts,
+		                         reset_code, reset_code_expires_at, reset_attempts, tokens_valid_after)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (sub) DO UPDATE SET
+			email = excluded.email,
+			password_hash = excluded.password_hash,
+			confirmed = excluded.confirmed,
+			confirmation_code = excluded.confirmation_code,
+			code_expires_at = excluded.code_expires_at,
+			code_attempts = excluded.code_attempts,
+			reset_code = excluded.reset_code,
+			reset_code_expires_at = excluded.reset_code_expires_at,
+			reset_attempts = excluded.reset_attempts,
+			tokens_valid_after = excluded.tokens_valid_after`,
+		cred.Sub, cred.Email, cred.PasswordHash, cred.Confirmed, cred.ConfirmationCode,
+		formatTime(cred.CodeExpiresAt), cred.CodeAttempts, cred.ResetCode, formatTime(cred.ResetExpiresAt), cred.ResetAttempts,
+		formatPreciseTime(cred.TokensValidAfter),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to put credential: %w", err)
+	}
+	return nil
+}
+
+// RevokeToken records a token ID as revoked until the token would have expired
+func (s *Store) RevokeToken(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	// Expired entries can never match a valid token again
+	_, err := s.db.ExecContext(ctx, `DELETE FROM revoked_tokens WHERE expires_at < ?`, formatTime(time.Now()))
+	if err != nil {
+		return fmt.Errorf("failed to prune revoked tokens: %w", err)
+	}
+
+	_, err = s.db.ExecContext(ctx, `INSERT OR IGNORE INTO revoked_tokens (token_id, expires_at) VALUES (?, ?)`,
+		tokenID, formatTime(expiresAt))
+	if err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+	return nil
+}
+
+// IsTokenRevoked reports whether the token ID has been revoked
+func (s *Store) IsTokenRevoked(ctx context.Context, tokenID string) (bool, error) {
+	var count int
+	err := s.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM revoked_tokens WHERE token_id = ?`, tokenID).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check revoked token: %w", err)
+	}
+	return count > 0, nil
+}
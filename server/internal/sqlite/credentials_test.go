@@ -0,0 +1,104 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+	"github.com/mcgigglepop/acnh-finder/server/internal/repository"
+)
+
+func TestCredentials(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s store) {
+		ctx := context.Background()
+
+		if _, err := s.GetCredential(ctx, "player@example.com"); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("GetCredential of an unknown email err = %v, want ErrNotFound", err)
+		}
+
+		cred := models.Credential{
+			Sub:              "sub-1",
+			Email:            "player@example.com",
+			PasswordHash:     "hash",
+			ConfirmationCode: "123456",
+			CodeExpiresAt:    time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC),
+			CodeAttempts:     2,
+		}
+		if err := s.PutCredential(ctx, cred); err != nil {
+			t.Fatalf("PutCredential: %v", err)
+		}
+		got, err := s.GetCredential(ctx, cred.Email)
+		if err != nil {
+			t.Fatalf("GetCredential: %v", err)
+		}
+		if !reflect.DeepEqual(*got, cred) {
+			t.Errorf("GetCredential = %+v, want %+v", *got, cred)
+		}
+
+		// Updates replace the account, and the sign out cutoff keeps its
+		// sub-second part
+		cred.Confirmed = true
+		cred.ConfirmationCode = ""
+		cred.CodeExpiresAt = time.Time{}
+		cred.CodeAttempts = 0
+		cred.ResetCode = "654321"
+		cred.ResetExpiresAt = time.Date(2026, time.March, 1, 13, 0, 0, 0, time.UTC)
+		cred.ResetAttempts = 4
+		cred.TokensValidAfter = time.Date(2026, time.March, 1, 12, 0, 0, 123456789, time.UTC)
+		if err := s.PutCredential(ctx, cred); err != nil {
+			t.Fatalf("PutCredential update: %v", err)
+		}
+		got, err = s.GetCredential(ctx, cred.Email)
+		if err != nil {
+			t.Fatalf("GetCredential: %v", err)
+		}
+		if !reflect.DeepEqual(*got, cred) {
+			t.Errorf("GetCredential after update = %+v, want %+v", *got, cred)
+		}
+	})
+}
+
+func TestRevokedTokens(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s store) {
+		ctx := context.Background()
+
+		revoked, err := s.IsTokenRevoked(ctx, "token-1")
+		if err != nil {
+			t.Fatalf("IsTokenRevoked: %v", err)
+		}
+		if revoked {
+			t.Errorf("token-1 revoked before RevokeToken")
+		}
+
+		if err := s.RevokeToken(ctx, "token-1", time.Now().Add(time.Hour)); err != nil {
+			t.Fatalf("RevokeToken: %v", err)
+		}
+		// Revoking twice is not an error
+		if err := s.RevokeToken(ctx, "token-1", time.Now().Add(time.Hour)); err != nil {
+			t.Fatalf("RevokeToken again: %v", err)
+		}
+		if revoked, err := s.IsTokenRevoked(ctx, "token-1"); err != nil || !revoked {
+			t.Errorf("IsTokenRevoked(token-1) = %v, %v, want true", revoked, err)
+		}
+		if revoked, err := s.IsTokenRevoked(ctx, "token-2"); err != nil || revoked {
+			t.Errorf("IsTokenRevoked(token-2) = %v, %v, want false", revoked, err)
+		}
+
+		// Revocations of tokens that have since expired are pruned
+		if err := s.RevokeToken(ctx, "token-2", time.Now().Add(-time.Hour)); err != nil {
+			t.Fatalf("RevokeToken: %v", err)
+		}
+		if err := s.RevokeToken(ctx, "token-3", time.Now().Add(time.Hour)); err != nil {
+			t.Fatalf("RevokeToken: %v", err)
+		}
+		if revoked, err := s.IsTokenRevoked(ctx, "token-2"); err != nil || revoked {
+			t.Errorf("IsTokenRevoked(token-2) after pruning = %v, %v, want false", revoked, err)
+		}
+		if revoked, err := s.IsTokenRevoked(ctx, "token-1"); err != nil || !revoked {
+			t.Errorf("IsTokenRevoked(token-1) after pruning = %v, %v, want true", revoked, err)
+		}
+	})
+}
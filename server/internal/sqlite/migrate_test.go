@@ -0,0 +1,113 @@
+package sqlite
+
+import (
+	"context"
+	"slices"
+	"testing"
+)
+
+func TestLoadMigrations(t *testing.T) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		t.Fatalf("loadMigrations: %v", err)
+	}
+	if len(migrations) < 14 {
+		t.Fatalf("loaded %d migrations, want at least 14", len(migrations))
+	}
+	// Versions run from 1 with no gaps or repeats
+	for i, m := range migrations {
+		if m.version != i+1 {
+			t.Errorf("migration %s has version %d, want %d", m.name, m.version, i+1)
+		}
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	ctx := context.Background()
+	s := openTestStore(t)
+
+	migrations, err := loadMigrations()
+	if err != nil {
+		t.Fatalf("loadMigrations: %v", err)
+	}
+
+	var applied int
+	if err := s.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM schema_migrations`).Scan(&applied); err != nil {
+		t.Fatalf("failed to count migrations: %v", err)
+	}
+	if applied != len(migrations) {
+		t.Errorf("%d migrations recorded, want %d", applied, len(migrations))
+	}
+
+	// Running again applies nothing and fails on nothing
+	if err := s.Migrate(ctx); err != nil {
+		t.Fatalf("Migrate again: %v", err)
+	}
+
+	// Every column the stores read exists
+	queries := []string{
+		`SELECT user_id, hemisphere, time_zone, clock_offset, created_at, updated_at FROM user_profiles`,
+		`SELECT sub, email, password_hash, confirmed, confirmation_code, code_expires_at, code_attempts,
+		        reset_code, reset_code_expires_at, reset_attempts, tokens_valid_after FROM credentials`,
+		`SELECT token_id, expires_at FROM revoked_tokens`,
+		`SELECT category, id, name, icon, sell_price, north_availability, south_availability, attributes FROM collectibles`,
+		`SELECT user_id, category, id, caught, caught_at, donated, donated_at, duplicates FROM user_collectibles`,
+		`SELECT ` + villagerColumns + ` FROM villagers`,
+		`SELECT user_id, villager_id, moved_in_at, moved_out_at FROM user_residents`,
+	}
+	for _, query := range queries {
+		rows, err := s.db.QueryContext(ctx, query)
+		if err != nil {
+			t.Errorf("query failed after migrating: %v\n%s", err, query)
+			continue
+		}
+		rows.Close()
+	}
+}
+
+// TestMigrateVillagerSlugs runs 0013 over residents stored under the old
+// "N-slug" villager IDs
+func TestMigrateVillagerSlugs(t *testing.T) {
+	ctx := context.Background()
+	s := openTestStore(t)
+
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM schema_migrations WHERE version = 13`); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SeedVillagers(ctx, testVillagers[:1]); err != nil {
+		t.Fatal(err)
+	}
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO user_residents (user_id, villager_id, moved_in_at) VALUES
+			('user-1', '35-raymond', '2026-01-01T00:00:00Z'),
+			('user-1', '412-t-bone', '2026-01-02T00:00:00Z'),
+			('user-1', 'agent-s', '2026-01-03T00:00:00Z')`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.Migrate(ctx); err != nil {
+		t.Fatalf("Migrate: %v", err)
+	}
+
+	history, err := residentHistory(ctx, s.db, "user-1")
+	if err != nil {
+		t.Fatalf("residentHistory: %v", err)
+	}
+	var ids []string
+	for _, r := range history {
+		ids = append(ids, r.VillagerID)
+	}
+	if want := []string{"raymond", "t-bone", "agent-s"}; !slices.Equal(ids, want) {
+		t.Errorf("resident IDs after migrating = %v, want %v", ids, want)
+	}
+
+	// The directory is cleared for the startup seed to refill
+	villagers, err := s.ListVillagers(ctx)
+	if err != nil {
+		t.Fatalf("ListVillagers: %v", err)
+	}
+	if len(villagers) != 0 {
+		t.Errorf("%d villagers left after migrating, want 0", len(villagers))
+	}
+}
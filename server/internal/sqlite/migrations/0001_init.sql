@@ -0,0 +1,23 @@
+CREATE TABLE user_profiles (
+    user_id    TEXT PRIMARY KEY,
+    hemisphere TEXT NOT NULL DEFAULT 'unset'
+);
+
+CREATE TABLE fish (
+    fish_id            TEXT PRIMARY KEY,
+    name               TEXT NOT NULL,
+    icon               TEXT NOT NULL DEFAULT '',
+    sell_price         INTEGER NOT NULL DEFAULT 0,
+    shadow_size        TEXT NOT NULL DEFAULT '',
+    shadow_icon        TEXT NOT NULL DEFAULT '',
+    location           TEXT NOT NULL DEFAULT '',
+    north_availability TEXT NOT NULL DEFAULT '[]',
+    south_availability TEXT NOT NULL DEFAULT '[]'
+);
+
+CREATE TABLE user_fish (
+    user_id TEXT NOT NULL,
+    fish_id TEXT NOT NULL,
+    caught  INTEGER NOT NULL DEFAULT 1,
+    PRIMARY KEY (user_id, fish_id)
+);
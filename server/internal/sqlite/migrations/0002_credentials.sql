@@ -0,0 +1,8 @@
+CREATE TABLE credentials (
+    sub               TEXT PRIMARY KEY,
+    email             TEXT NOT NULL UNIQUE,
+    password_hash     TEXT NOT NULL,
+    confirmed         INTEGER NOT NULL DEFAULT 0,
+    confirmation_code TEXT NOT NULL DEFAULT '',
+    code_expires_at   TEXT NOT NULL DEFAULT ''
+);
@@ -0,0 +1,6 @@
+ALTER TABLE credentials ADD COLUMN tokens_valid_after TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE revoked_tokens (
+    token_id   TEXT PRIMARY KEY,
+    expires_at TEXT NOT NULL
+);
@@ -0,0 +1,2 @@
+ALTER TABLE credentials ADD COLUMN reset_code TEXT NOT NULL DEFAULT '';
+ALTER TABLE credentials ADD COLUMN reset_code_expires_at TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+ALTER TABLE user_profiles ADD COLUMN created_at TEXT NOT NULL DEFAULT '';
+ALTER TABLE user_profiles ADD COLUMN updated_at TEXT NOT NULL DEFAULT '';
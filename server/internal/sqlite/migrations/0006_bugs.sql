@@ -0,0 +1,17 @@
+CREATE TABLE bugs (
+    bug_id             TEXT PRIMARY KEY,
+    name               TEXT NOT NULL,
+    icon               TEXT NOT NULL DEFAULT '',
+    sell_price         INTEGER NOT NULL DEFAULT 0,
+    location           TEXT NOT NULL DEFAULT '',
+    weather            TEXT NOT NULL DEFAULT '',
+    north_availability TEXT NOT NULL DEFAULT '[]',
+    south_availability TEXT NOT NULL DEFAULT '[]'
+);
+
+CREATE TABLE user_bugs (
+    user_id TEXT NOT NULL,
+    bug_id  TEXT NOT NULL,
+    caught  INTEGER NOT NULL DEFAULT 1,
+    PRIMARY KEY (user_id, bug_id)
+);
@@ -0,0 +1,18 @@
+CREATE TABLE sea_creatures (
+    sea_creature_id    TEXT PRIMARY KEY,
+    name               TEXT NOT NULL,
+    icon               TEXT NOT NULL DEFAULT '',
+    sell_price         INTEGER NOT NULL DEFAULT 0,
+    shadow_size        TEXT NOT NULL DEFAULT '',
+    shadow_icon        TEXT NOT NULL DEFAULT '',
+    swimming_speed     TEXT NOT NULL DEFAULT '',
+    north_availability TEXT NOT NULL DEFAULT '[]',
+    south_availability TEXT NOT NULL DEFAULT '[]'
+);
+
+CREATE TABLE user_sea_creatures (
+    user_id         TEXT NOT NULL,
+    sea_creature_id TEXT NOT NULL,
+    caught          INTEGER NOT NULL DEFAULT 1,
+    PRIMARY KEY (user_id, sea_creature_id)
+);
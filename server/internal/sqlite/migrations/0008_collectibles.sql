@@ -0,0 +1,34 @@
+CREATE TABLE collectibles (
+    category           TEXT NOT NULL,
+    id                 TEXT NOT NULL,
+    name               TEXT NOT NULL,
+    icon               TEXT NOT NULL DEFAULT '',
+    sell_price         INTEGER NOT NULL DEFAULT 0,
+    north_availability TEXT NOT NULL DEFAULT '[]',
+    south_availability TEXT NOT NULL DEFAULT '[]',
+    attributes         TEXT NOT NULL DEFAULT '{}',
+    PRIMARY KEY (category, id)
+);
+
+CREATE TABLE user_collectibles (
+    user_id  TEXT NOT NULL,
+    category TEXT NOT NULL,
+    id       TEXT NOT NULL,
+    caught   INTEGER NOT NULL DEFAULT 1,
+    PRIMARY KEY (user_id, category, id)
+);
+
+-- Carry catch records over; the catalog itself is reseeded on startup
+INSERT INTO user_collectibles (user_id, category, id, caught)
+    SELECT user_id, 'fish', fish_id, caught FROM user_fish;
+INSERT INTO user_collectibles (user_id, category, id, caught)
+    SELECT user_id, 'bug', bug_id, caught FROM user_bugs;
+INSERT INTO user_collectibles (user_id, category, id, caught)
+    SELECT user_id, 'sea_creature', sea_creature_id, caught FROM user_sea_creatures;
+
+DROP TABLE user_fish;
+DROP TABLE user_bugs;
+DROP TABLE user_sea_creatures;
+DROP TABLE fish;
+DROP TABLE bugs;
+DROP TABLE sea_creatures;
@@ -0,0 +1,3 @@
+ALTER TABLE user_collectibles ADD COLUMN caught_at TEXT NOT NULL DEFAULT '';
+ALTER TABLE user_collectibles ADD COLUMN donated INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE user_collectibles ADD COLUMN donated_at TEXT NOT NULL DEFAULT '';
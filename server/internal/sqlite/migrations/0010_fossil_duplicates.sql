@@ -0,0 +1 @@
+ALTER TABLE user_collectibles ADD COLUMN duplicates INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,21 @@
+CREATE TABLE villagers (
+    villager_id TEXT PRIMARY KEY,
+    name        TEXT NOT NULL,
+    icon        TEXT NOT NULL DEFAULT '',
+    species     TEXT NOT NULL DEFAULT '',
+    personality TEXT NOT NULL DEFAULT '',
+    birth_month INTEGER NOT NULL DEFAULT 0,
+    birth_day   INTEGER NOT NULL DEFAULT 0,
+    hobby       TEXT NOT NULL DEFAULT '',
+    catchphrase TEXT NOT NULL DEFAULT ''
+);
+
+-- One row per stay; moved_out_at stays empty while the villager lives there
+CREATE TABLE user_residents (
+    user_id      TEXT NOT NULL,
+    villager_id  TEXT NOT NULL,
+    moved_in_at  TEXT NOT NULL,
+    moved_out_at TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX user_residents_user_id ON user_residents (user_id);
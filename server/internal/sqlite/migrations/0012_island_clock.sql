@@ -0,0 +1,2 @@
+ALTER TABLE user_profiles ADD COLUMN time_zone TEXT NOT NULL DEFAULT '';
+ALTER TABLE user_profiles ADD COLUMN clock_offset INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,6 @@
+-- Villager IDs no longer carry the villager's position in the directory, so
+-- "412-raymond" is now "raymond". The directory is reseeded on startup.
+DELETE FROM villagers;
+UPDATE user_residents
+SET villager_id = substr(villager_id, instr(villager_id, '-') + 1)
+WHERE villager_id GLOB '[0-9]*-*';
@@ -0,0 +1,2 @@
+ALTER TABLE credentials ADD COLUMN code_attempts INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE credentials ADD COLUMN reset_attempts INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,136 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+	"github.com/mcgigglepop/acnh-finder/server/internal/repository"
+	_ "modernc.org/sqlite"
+)
+
+// Store implements every store against a single SQLite database
+type Store struct {
+	db *sql.DB
+}
+
+var (
+	_ repository.UserProfileStore = (*Store)(nil)
+	_ repository.CatalogStore     = (*Store)(nil)
+	_ repository.CollectionStore  = (*Store)(nil)
+	_ repository.VillagerStore    = (*Store)(nil)
+	_ repository.CredentialStore  = (*Store)(nil)
+)
+
+// Open opens (or creates) the database at path and applies pending migrations
+func Open(ctx context.Context, path string) (*Store, error) {
+	db, err := sql.Open("sqlite", fmt.Sprintf("file:%s?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)", path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sqlite database: %w", err)
+	}
+
+	// SQLite allows a single writer; serialising through one connection
+	// avoids SQLITE_BUSY errors under concurrent requests.
+	db.SetMaxOpenConns(1)
+
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to connect to sqlite database: %w", err)
+	}
+
+	s := &Store{db: db}
+	if err := s.Migrate(ctx); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Close closes the underlying database
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+func (s *Store) CreateUserProfile(ctx context.Context, userSub string) error {
+	now := formatTime(time.Now())
+	_, err := s.db.ExecContext(ctx, `
+		INSERT OR IGNORE INTO user_profiles (user_id, hemisphere, created_at, updated_at)
+		VALUES (?, ?, ?, ?)`,
+		userSub, models.HemisphereUnset, now, now,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create profile: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) GetUserProfile(ctx context.Context, userSub string) (*models.User, error) {
+	var user models.User
+	var createdAt, updatedAt string
+	err := s.db.QueryRowContext(ctx,
+		`SELECT user_id, hemisphere, time_zone, clock_offset, created_at, updated_at FROM user_profiles WHERE user_id = ?`, userSub,
+	).Scan(&user.UserID, &user.Hemisphere, &user.TimeZone, &user.ClockOffset, &createdAt, &updatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile: %w", err)
+	}
+
+	if user.CreatedAt, err = parseTime(createdAt); err != nil {
+		return nil, fmt.Errorf("failed to parse profile created_at: %w", err)
+	}
+	if user.UpdatedAt, err = parseTime(updatedAt); err != nil {
+		return nil, fmt.Errorf("failed to parse profile updated_at: %w", err)
+	}
+	if user.ResidentHistory, err = residentHistory(ctx, s.db, userSub); err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func (s *Store) UpdateUserHemisphere(ctx context.Context, userSub string, hemisphere string) error {
+	res, err := s.db.ExecContext(ctx,
+		`UPDATE user_profiles SET hemisphere = ?, updated_at = ? WHERE user_id = ?`,
+		hemisphere, formatTime(time.Now()), userSub,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update hemisphere: %w", err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to update hemisphere: %w", err)
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (s *Store) UpdateUserClock(ctx context.Context, userSub string, timeZone string, clockOffset int) error {
+	res, err := s.db.ExecContext(ctx,
+		`UPDATE user_profiles SET time_zone = ?, clock_offset = ?, updated_at = ? WHERE user_id = ?`,
+		timeZone, clockOffset, formatTime(time.Now()), userSub,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update clock: %w", err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to update clock: %w", err)
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
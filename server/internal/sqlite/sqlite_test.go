@@ -0,0 +1,138 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/memory"
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+	"github.com/mcgigglepop/acnh-finder/server/internal/repository"
+)
+
+// store is every interface the backends implement
+type store interface {
+	repository.UserProfileStore
+	repository.VillagerStore
+	repository.CatalogStore
+	repository.CollectionStore
+	repository.CredentialStore
+}
+
+var testCatalog = []models.Collectible{
+	{
+		Category:          models.CategoryFish,
+		ID:                "bitterling",
+		Name:              "Bitterling",
+		Icon:              "/static/images/fish/icons/bitterling.png",
+		SellPrice:         900,
+		NorthAvailability: []models.SeasonalAvailability{{Months: []int{11, 12, 1, 2, 3}, TimeRanges: []models.TimeRange{{Start: "00:00", End: "23:59"}}}},
+		SouthAvailability: []models.SeasonalAvailability{{Months: []int{5, 6, 7, 8, 9}, TimeRanges: []models.TimeRange{{Start: "00:00", End: "23:59"}}}},
+		Attributes:        map[string]string{"location": "River", "shadow": "1"},
+	},
+	{
+		Category:          models.CategoryFish,
+		ID:                "pale-chub",
+		Name:              "Pale chub",
+		Icon:              "/static/images/fish/icons/pale-chub.png",
+		SellPrice:         200,
+		NorthAvailability: []models.SeasonalAvailability{{Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}, TimeRanges: []models.TimeRange{{Start: "09:00", End: "16:00"}}}},
+		SouthAvailability: []models.SeasonalAvailability{{Months: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}, TimeRanges: []models.TimeRange{{Start: "09:00", End: "16:00"}}}},
+		Attributes:        map[string]string{"location": "River", "shadow": "1"},
+	},
+	{
+		Category:          models.CategoryFossil,
+		ID:                "amber",
+		Name:              "Amber",
+		Icon:              "/static/images/fossils/icons/amber.png",
+		SellPrice:         1200,
+		NorthAvailability: []models.SeasonalAvailability{},
+		SouthAvailability: []models.SeasonalAvailability{},
+		Attributes:        map[string]string{},
+	},
+}
+
+var testVillagers = []models.Villager{
+	{VillagerID: "raymond", Name: "Raymond", Species: "Cat", Personality: "Smug", BirthMonth: 10, BirthDay: 1, Hobby: "Nature", Catchphrase: "crisp"},
+	{VillagerID: "agent-s", Name: "Agent S", Species: "Squirrel", Personality: "Peppy", BirthMonth: 7, BirthDay: 2, Hobby: "Fitness", Catchphrase: "sidekick"},
+	{VillagerID: "t-bone", Name: "T-Bone", Species: "Bull", Personality: "Cranky", BirthMonth: 5, BirthDay: 20, Hobby: "Fitness", Catchphrase: "moocher"},
+}
+
+// openTestStore opens a migrated, empty in-memory database
+func openTestStore(t *testing.T) *Store {
+	t.Helper()
+	s, err := Open(context.Background(), ":memory:")
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+// forEachStore runs the test against the memory store and a seeded SQLite
+// store, so that both backends are held to the same behaviour
+func forEachStore(t *testing.T, test func(t *testing.T, s store)) {
+	t.Run("memory", func(t *testing.T) {
+		test(t, memory.New(testCatalog, testVillagers))
+	})
+	t.Run("sqlite", func(t *testing.T) {
+		s := openTestStore(t)
+		ctx := context.Background()
+		if err := s.SeedCatalog(ctx, testCatalog); err != nil {
+			t.Fatalf("SeedCatalog: %v", err)
+		}
+		if err := s.SeedVillagers(ctx, testVillagers); err != nil {
+			t.Fatalf("SeedVillagers: %v", err)
+		}
+		test(t, s)
+	})
+}
+
+func TestUserProfiles(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s store) {
+		ctx := context.Background()
+
+		if _, err := s.GetUserProfile(ctx, "user-1"); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("GetUserProfile before creating err = %v, want ErrNotFound", err)
+		}
+		if err := s.UpdateUserHemisphere(ctx, "user-1", "north"); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("UpdateUserHemisphere before creating err = %v, want ErrNotFound", err)
+		}
+		if err := s.UpdateUserClock(ctx, "user-1", "UTC", 0); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("UpdateUserClock before creating err = %v, want ErrNotFound", err)
+		}
+
+		if err := s.CreateUserProfile(ctx, "user-1"); err != nil {
+			t.Fatalf("CreateUserProfile: %v", err)
+		}
+		user, err := s.GetUserProfile(ctx, "user-1")
+		if err != nil {
+			t.Fatalf("GetUserProfile: %v", err)
+		}
+		if user.UserID != "user-1" || user.Hemisphere != models.HemisphereUnset {
+			t.Errorf("new profile = %+v, want user-1 with no hemisphere", user)
+		}
+		if user.CreatedAt.IsZero() || user.UpdatedAt.IsZero() {
+			t.Errorf("new profile timestamps = %v, %v, want both set", user.CreatedAt, user.UpdatedAt)
+		}
+
+		if err := s.UpdateUserHemisphere(ctx, "user-1", "south"); err != nil {
+			t.Fatalf("UpdateUserHemisphere: %v", err)
+		}
+		if err := s.UpdateUserClock(ctx, "user-1", "Europe/London", -90); err != nil {
+			t.Fatalf("UpdateUserClock: %v", err)
+		}
+		// Creating the profile again leaves it as it is
+		if err := s.CreateUserProfile(ctx, "user-1"); err != nil {
+			t.Fatalf("CreateUserProfile again: %v", err)
+		}
+
+		user, err = s.GetUserProfile(ctx, "user-1")
+		if err != nil {
+			t.Fatalf("GetUserProfile: %v", err)
+		}
+		if user.Hemisphere != "south" || user.TimeZone != "Europe/London" || user.ClockOffset != -90 {
+			t.Errorf("updated profile = %+v, want south, Europe/London, -90", user)
+		}
+	})
+}
@@ -0,0 +1,192 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+	"github.com/mcgigglepop/acnh-finder/server/internal/repository"
+)
+
+// SeedVillagers inserts or refreshes the villager directory
+func (s *Store) SeedVillagers(ctx context.Context, villagers []models.Villager) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin seed: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, v := range villagers {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO villagers (villager_id, name, icon, species, personality, birth_month, birth_day, hobby, catchphrase)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT (villager_id) DO UPDATE SET
+				name = excluded.name,
+				icon = excluded.icon,
+				species = excluded.species,
+				personality = excluded.personality,
+				birth_month = excluded.birth_month,
+				birth_day = excluded.birth_day,
+				hobby = excluded.hobby,
+				catchphrase = excluded.catchphrase`,
+			v.VillagerID, v.Name, v.Icon, v.Species, v.Personality, v.BirthMonth, v.BirthDay, v.Hobby, v.Catchphrase,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert villager %s: %w", v.VillagerID, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+const villagerColumns = `villager_id, name, icon, species, personality, birth_month, birth_day, hobby, catchphrase`
+
+// scanner is satisfied by both *sql.Row and *sql.Rows
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanVillager(row scanner) (models.Villager, error) {
+	var v models.Villager
+	err := row.Scan(&v.VillagerID, &v.Name, &v.Icon, &v.Species, &v.Personality,
+		&v.BirthMonth, &v.BirthDay, &v.Hobby, &v.Catchphrase)
+	return v, err
+}
+
+func (s *Store) ListVillagers(ctx context.Context) ([]models.Villager, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT `+villagerColumns+` FROM villagers ORDER BY rowid`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query villagers: %w", err)
+	}
+	defer rows.Close()
+
+	var villagers []models.Villager
+	for rows.Next() {
+		v, err := scanVillager(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan villager: %w", err)
+		}
+		villagers = append(villagers, v)
+	}
+
+	return villagers, rows.Err()
+}
+
+func (s *Store) GetVillager(ctx context.Context, villagerID string) (*models.Villager, error) {
+	v, err := scanVillager(s.db.QueryRowContext(ctx,
+		`SELECT `+villagerColumns+` FROM villagers WHERE villager_id = ?`, villagerID,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get villager: %w", err)
+	}
+
+	return &v, nil
+}
+
+// queryer is satisfied by both *sql.DB and *sql.Tx
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// residentHistory loads every stay on the user's island, oldest first
+func residentHistory(ctx context.Context, q queryer, userSub string) ([]models.Residency, error) {
+	rows, err := q.QueryContext(ctx,
+		`SELECT villager_id, moved_in_at, moved_out_at FROM user_residents WHERE user_id = ? ORDER BY rowid`,
+		userSub,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query residents: %w", err)
+	}
+	defer rows.Close()
+
+	var history []models.Residency
+	for rows.Next() {
+		var r models.Residency
+		var movedIn, movedOut string
+		if err := rows.Scan(&r.VillagerID, &movedIn, &movedOut); err != nil {
+			return nil, fmt.Errorf("failed to scan resident: %w", err)
+		}
+		if r.MovedInAt, err = parseTime(movedIn); err != nil {
+			return nil, fmt.Errorf("failed to parse moved_in_at: %w", err)
+		}
+		if r.MovedOutAt, err = parseTime(movedOut); err != nil {
+			return nil, fmt.Errorf("failed to parse moved_out_at: %w", err)
+		}
+		history = append(history, r)
+	}
+
+	return history, rows.Err()
+}
+
+func (s *Store) MoveInVillager(ctx context.Context, userSub, villagerID string) error {
+	return s.updateResidents(ctx, userSub, func(tx *sql.Tx, u *models.User, now time.Time) error {
+		if err := u.MoveIn(villagerID, now); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO user_residents (user_id, villager_id, moved_in_at) VALUES (?, ?, ?)`,
+			userSub, villagerID, formatTime(now),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert resident: %w", err)
+		}
+		return nil
+	})
+}
+
+func (s *Store) MoveOutVillager(ctx context.Context, userSub, villagerID string) error {
+	return s.updateResidents(ctx, userSub, func(tx *sql.Tx, u *models.User, now time.Time) error {
+		if err := u.MoveOut(villagerID, now); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx,
+			`UPDATE user_residents SET moved_out_at = ? WHERE user_id = ? AND villager_id = ? AND moved_out_at = ''`,
+			formatTime(now), userSub, villagerID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update resident: %w", err)
+		}
+		return nil
+	})
+}
+
+// updateResidents loads the user's roster in a transaction, lets move
+// validate and write the change, and bumps the profile's updated_at
+func (s *Store) updateResidents(ctx context.Context, userSub string, move func(tx *sql.Tx, u *models.User, now time.Time) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin roster update: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now().UTC()
+	res, err := tx.ExecContext(ctx,
+		`UPDATE user_profiles SET updated_at = ? WHERE user_id = ?`,
+		formatTime(now), userSub,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update profile: %w", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return fmt.Errorf("failed to update profile: %w", err)
+	} else if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	user := models.User{UserID: userSub}
+	if user.ResidentHistory, err = residentHistory(ctx, tx, userSub); err != nil {
+		return err
+	}
+
+	if err := move(tx, &user, now); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,126 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/mcgigglepop/acnh-finder/server/internal/models"
+	"github.com/mcgigglepop/acnh-finder/server/internal/repository"
+)
+
+func TestVillagers(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s store) {
+		ctx := context.Background()
+
+		villagers, err := s.ListVillagers(ctx)
+		if err != nil {
+			t.Fatalf("ListVillagers: %v", err)
+		}
+		// Listed in seed order
+		if !reflect.DeepEqual(villagers, testVillagers) {
+			t.Errorf("ListVillagers = %+v, want %+v", villagers, testVillagers)
+		}
+
+		v, err := s.GetVillager(ctx, "t-bone")
+		if err != nil {
+			t.Fatalf("GetVillager: %v", err)
+		}
+		if !reflect.DeepEqual(*v, testVillagers[2]) {
+			t.Errorf("GetVillager = %+v, want %+v", *v, testVillagers[2])
+		}
+
+		if _, err := s.GetVillager(ctx, "nobody"); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("GetVillager of an unknown villager err = %v, want ErrNotFound", err)
+		}
+	})
+}
+
+// residentIDs returns the user's current residents, and every stay as
+// "<id> in" or "<id> out"
+func residentIDs(t *testing.T, s store, userSub string) (current []string, history []string) {
+	t.Helper()
+	user, err := s.GetUserProfile(context.Background(), userSub)
+	if err != nil {
+		t.Fatalf("GetUserProfile: %v", err)
+	}
+	for _, r := range user.ResidentHistory {
+		if r.MovedInAt.IsZero() {
+			t.Errorf("%s has no move-in time", r.VillagerID)
+		}
+		state := " in"
+		if !r.Current() {
+			state = " out"
+		}
+		history = append(history, r.VillagerID+state)
+	}
+	return user.Residents(), history
+}
+
+func TestResidents(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s store) {
+		ctx := context.Background()
+
+		if err := s.MoveInVillager(ctx, "user-1", "raymond"); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("MoveInVillager without a profile err = %v, want ErrNotFound", err)
+		}
+		if err := s.CreateUserProfile(ctx, "user-1"); err != nil {
+			t.Fatalf("CreateUserProfile: %v", err)
+		}
+
+		steps := []struct {
+			moveIn bool
+			id     string
+			err    error
+		}{
+			{true, "raymond", nil},
+			{true, "agent-s", nil},
+			{true, "raymond", models.ErrAlreadyResident},
+			{false, "t-bone", models.ErrNotResident},
+			{false, "raymond", nil},
+			{false, "raymond", models.ErrNotResident},
+			{true, "raymond", nil},
+		}
+		for _, step := range steps {
+			var err error
+			if step.moveIn {
+				err = s.MoveInVillager(ctx, "user-1", step.id)
+			} else {
+				err = s.MoveOutVillager(ctx, "user-1", step.id)
+			}
+			if !errors.Is(err, step.err) {
+				t.Fatalf("move in %v of %s err = %v, want %v", step.moveIn, step.id, err, step.err)
+			}
+		}
+
+		current, history := residentIDs(t, s, "user-1")
+		if want := []string{"agent-s", "raymond"}; !reflect.DeepEqual(current, want) {
+			t.Errorf("residents = %v, want %v", current, want)
+		}
+		if want := []string{"raymond out", "agent-s in", "raymond in"}; !reflect.DeepEqual(history, want) {
+			t.Errorf("history = %v, want %v", history, want)
+		}
+
+		// The roster tops out at MaxResidents
+		if err := s.CreateUserProfile(ctx, "user-2"); err != nil {
+			t.Fatalf("CreateUserProfile: %v", err)
+		}
+		for i := 0; i < models.MaxResidents; i++ {
+			if err := s.MoveInVillager(ctx, "user-2", string(rune('a'+i))); err != nil {
+				t.Fatalf("MoveInVillager %d: %v", i, err)
+			}
+		}
+		if err := s.MoveInVillager(ctx, "user-2", "raymond"); !errors.Is(err, models.ErrRosterFull) {
+			t.Errorf("MoveInVillager onto a full island err = %v, want ErrRosterFull", err)
+		}
+		if current, _ := residentIDs(t, s, "user-2"); len(current) != models.MaxResidents {
+			t.Errorf("%d residents after a failed move-in, want %d", len(current), models.MaxResidents)
+		}
+
+		// Other islands are untouched
+		if current, _ := residentIDs(t, s, "user-1"); len(current) != 2 {
+			t.Errorf("user-1 has %d residents, want 2", len(current))
+		}
+	})
+}
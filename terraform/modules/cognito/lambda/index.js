@@ -10,12 +10,17 @@ exports.handler = async (event, context, callback) => {
     return callback("Error: No user sub provided.");
   }
 
+  const now = new Date().toISOString();
   const params = {
     TableName: "UserProfiles",
     Item: {
       user_id: userId,
       hemisphere: defaultHemisphere,
+      created_at: now,
+      updated_at: now,
     },
+    // The app also provisions on first login, so never clobber a profile
+    ConditionExpression: "attribute_not_exists(user_id)",
   };
 
   try {
@@ -23,6 +28,10 @@ exports.handler = async (event, context, callback) => {
     console.log(`User ${userId} profile created.`);
     callback(null, event);
   } catch (err) {
+    if (err.code === "ConditionalCheckFailedException") {
+      console.log(`User ${userId} profile already exists.`);
+      return callback(null, event);
+    }
     console.error("Error writing to DynamoDB", err);
     callback(err);
   }
@@ -13,6 +13,67 @@ resource "aws_dynamodb_table" "user_profiles" {
   }
 }
 
+resource "aws_dynamodb_table" "collectibles" {
+  name           = "Collectibles"
+  billing_mode   = "PAY_PER_REQUEST"
+  hash_key       = "category"
+  range_key      = "id"
+
+  attribute {
+    name = "category"
+    type = "S"
+  }
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+
+  tags = {
+    Name = "Collectibles"
+  }
+}
+
+resource "aws_dynamodb_table" "villagers" {
+  name           = "Villagers"
+  billing_mode   = "PAY_PER_REQUEST"
+  hash_key       = "villager_id"
+
+  attribute {
+    name = "villager_id"
+    type = "S"
+  }
+
+  tags = {
+    Name = "Villagers"
+  }
+}
+
+resource "aws_dynamodb_table" "user_collectibles" {
+  name           = "UserCollectibles"
+  billing_mode   = "PAY_PER_REQUEST"
+  hash_key       = "PK"
+  range_key      = "SK"
+
+  attribute {
+    name = "PK"
+    type = "S"
+  }
+
+  attribute {
+    name = "SK"
+    type = "S"
+  }
+
+  tags = {
+    Name        = "UserCollectibles"
+  }
+}
+
+# Per-critter tables replaced by Collectibles and UserCollectibles. They are
+# kept until `go run ./cmd/web/migrate` (from server/) has copied their catch
+# records over, after which prevent_destroy can be lifted and the tables
+# removed.
 resource "aws_dynamodb_table" "fish" {
   name           = "Fish"
   billing_mode   = "PAY_PER_REQUEST"
@@ -23,6 +84,10 @@ resource "aws_dynamodb_table" "fish" {
     type = "S"
   }
 
+  lifecycle {
+    prevent_destroy = true
+  }
+
   tags = {
     Name = "Fish"
   }
@@ -44,8 +109,99 @@ resource "aws_dynamodb_table" "user_fish" {
     type = "S"
   }
 
+  lifecycle {
+    prevent_destroy = true
+  }
+
   tags = {
     Name        = "UserFish"
   }
 }
 
+resource "aws_dynamodb_table" "bugs" {
+  name           = "Bugs"
+  billing_mode   = "PAY_PER_REQUEST"
+  hash_key       = "bug_id"
+
+  attribute {
+    name = "bug_id"
+    type = "S"
+  }
+
+  lifecycle {
+    prevent_destroy = true
+  }
+
+  tags = {
+    Name = "Bugs"
+  }
+}
+
+resource "aws_dynamodb_table" "user_bugs" {
+  name           = "UserBugs"
+  billing_mode   = "PAY_PER_REQUEST"
+  hash_key       = "PK"
+  range_key      = "SK"
+
+  attribute {
+    name = "PK"
+    type = "S"
+  }
+
+  attribute {
+    name = "SK"
+    type = "S"
+  }
+
+  lifecycle {
+    prevent_destroy = true
+  }
+
+  tags = {
+    Name        = "UserBugs"
+  }
+}
+
+resource "aws_dynamodb_table" "sea_creatures" {
+  name           = "SeaCreatures"
+  billing_mode   = "PAY_PER_REQUEST"
+  hash_key       = "sea_creature_id"
+
+  attribute {
+    name = "sea_creature_id"
+    type = "S"
+  }
+
+  lifecycle {
+    prevent_destroy = true
+  }
+
+  tags = {
+    Name = "SeaCreatures"
+  }
+}
+
+resource "aws_dynamodb_table" "user_sea_creatures" {
+  name           = "UserSeaCreatures"
+  billing_mode   = "PAY_PER_REQUEST"
+  hash_key       = "PK"
+  range_key      = "SK"
+
+  attribute {
+    name = "PK"
+    type = "S"
+  }
+
+  attribute {
+    name = "SK"
+    type = "S"
+  }
+
+  lifecycle {
+    prevent_destroy = true
+  }
+
+  tags = {
+    Name        = "UserSeaCreatures"
+  }
+}